@@ -0,0 +1,242 @@
+// Command dictctl loads, exports and inspects the dictionary verdicts cached on redis,
+// to seed new environments and to back up what was learnt from the online dictionaries.
+//
+//	dictctl import -language id-id [-invalid] words.txt
+//	dictctl import backup.jsonl
+//	dictctl prewarm -language id-id words.txt
+//	dictctl export [-language id-id] [-output backup.jsonl]
+//	dictctl stats [-language id-id]
+//
+// Imported verdicts never expire, prewarmed ones expire like the ones cached by the game.
+// A .jsonl file is an export, any other file is a word list of one word per line.
+// With MYSQL_DSN set, import and prewarm write through to the lemmas table as the game does,
+// imported verdicts being overridden there.
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/joho/godotenv"
+
+	data_dictionary "github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/satriahrh/letter-block/data/transactional"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+)
+
+const usage = `usage: dictctl <command> [flags]
+
+commands:
+  import   cache the verdicts of a file without expiration
+  prewarm  cache the verdicts of a file, expiring as the game does
+  export   write the cached verdicts as JSON lines
+  stats    count the cached verdicts per language
+`
+
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err := godotenv.Load(".env"); err != nil {
+		log.Printf("Error loading .env, will be using system's environment variables instead: %v\n", err)
+	}
+
+	redisOptions, err := redis.ParseURL(os.Getenv("REDIS_URL"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	// the same verdict lifetimes as the game, without the in-process tier
+	dict := data_dictionary.NewDictionary(7*24*time.Hour, 24*time.Hour, 0, redis.NewClient(redisOptions))
+	if dsn := os.Getenv("MYSQL_DSN"); dsn != "" {
+		db, err := sql.Open("mysql", dsn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		dict = dict.WithStore(transactional.NewTransactional(db))
+	}
+
+	ctx := context.Background()
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "import":
+		err = load(ctx, dict, command, args, true)
+	case "prewarm":
+		err = load(ctx, dict, command, args, false)
+	case "export":
+		err = export(ctx, dict, args)
+	case "stats":
+		err = stats(ctx, dict, args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func load(ctx context.Context, dict *data_dictionary.Dictionary, command string, args []string, permanent bool) error {
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	language := flags.String("language", "", "dictionary language of a word list, e.g. id-id")
+	invalid := flags.Bool("invalid", false, "the words of the word list are invalid, e.g. a block list")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%v takes exactly one file", command)
+	}
+	path := flags.Arg(0)
+
+	count := 0
+	put := func(record data_dictionary.Record) error {
+		count++
+		if record.Source == "" {
+			record.Source = command
+		}
+		return dict.Put(ctx, record, permanent)
+	}
+
+	var err error
+	if filepath.Ext(path) == ".jsonl" {
+		err = readRecords(path, put)
+	} else {
+		if *language == "" {
+			return fmt.Errorf("-language is required for a word list")
+		}
+		var wordList *word_list.WordList
+		wordList, err = word_list.Open(path)
+		if err != nil {
+			return err
+		}
+		for _, word := range wordList.Words() {
+			if err = put(data_dictionary.Record{Language: *language, Word: word, Valid: !*invalid}); err != nil {
+				break
+			}
+		}
+	}
+	log.Printf("%v %v verdicts from %v", command, count, path)
+	return err
+}
+
+func readRecords(path string, fn func(data_dictionary.Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	// entries with many definitions make long lines
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var record data_dictionary.Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("%v:%v: %v", path, line, err)
+		}
+		if record.Language == "" || record.Word == "" {
+			return fmt.Errorf("%v:%v: language and word are required", path, line)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func export(ctx context.Context, dict *data_dictionary.Dictionary, args []string) (err error) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	language := flags.String("language", "", "dictionary language to export, every language when empty")
+	output := flags.String("output", "", "file to write, the standard output when empty")
+	_ = flags.Parse(args)
+
+	var writer io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		writer = file
+	}
+
+	buffered := bufio.NewWriter(writer)
+	encoder := json.NewEncoder(buffered)
+	count := 0
+	err = dict.Records(ctx, *language, func(record data_dictionary.Record) error {
+		count++
+		return encoder.Encode(record)
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("export %v verdicts", count)
+	return buffered.Flush()
+}
+
+type languageStats struct {
+	valid   int
+	invalid int
+	entries int
+}
+
+func stats(ctx context.Context, dict *data_dictionary.Dictionary, args []string) error {
+	flags := flag.NewFlagSet("stats", flag.ExitOnError)
+	language := flags.String("language", "", "dictionary language to count, every language when empty")
+	_ = flags.Parse(args)
+
+	counts := make(map[string]*languageStats)
+	err := dict.Records(ctx, *language, func(record data_dictionary.Record) error {
+		count, ok := counts[record.Language]
+		if !ok {
+			count = &languageStats{}
+			counts[record.Language] = count
+		}
+		if record.Valid {
+			count.valid++
+		} else {
+			count.invalid++
+		}
+		if record.Entry != nil {
+			count.entries++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	languages := make([]string, 0, len(counts))
+	for language := range counts {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(writer, "language\tverdicts\tvalid\tinvalid\tvalid ratio\tdefinitions\t")
+	for _, language := range languages {
+		count := counts[language]
+		total := count.valid + count.invalid
+		fmt.Fprintf(writer, "%v\t%v\t%v\t%v\t%.1f%%\t%v\t\n",
+			language, total, count.valid, count.invalid, 100*float64(count.valid)/float64(total), count.entries)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,78 @@
+// Command dictionary-server serves the dictionaries over HTTP/JSON, see remote.Server,
+// so game servers share one dictionary wiring by setting DICTIONARY_URL.
+// It is configured like the game server: MYSQL_DSN, REDIS_URL, ID_ID_MORPHOLOGY,
+// WORD_LIST_ID_ID, WORD_LIST_EN_US, LANGUAGE_PACKS and BLOOM_FILTER_LANGUAGES. ADMIN_TOKEN guards /debug/vars.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-redis/redis"
+	"github.com/joho/godotenv"
+
+	data_dictionary "github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/satriahrh/letter-block/data/transactional"
+	"github.com/satriahrh/letter-block/dictionary/registry"
+	"github.com/satriahrh/letter-block/dictionary/remote"
+	"github.com/satriahrh/letter-block/middleware/admin"
+)
+
+const defaultPort = "8081"
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	err := godotenv.Load(".env")
+	if err != nil {
+		log.Printf("Error loading .env, will be using system's environment variables instead: %v\n", err)
+	}
+
+	db, err := sql.Open(
+		"mysql",
+		os.Getenv("MYSQL_DSN"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	redisOptions, err := redis.ParseURL(os.Getenv("REDIS_URL"))
+	if err != nil {
+		panic(err)
+	}
+	redisClient := redis.NewClient(redisOptions)
+
+	tran := transactional.NewTransactional(db)
+	// negative verdicts expire sooner, KBBI keeps adding lemma; redis is a hot cache over the lemmas table
+	dataDict := data_dictionary.NewDictionary(7*24*time.Hour, 24*time.Hour, 10000, redisClient).WithStore(tran)
+	// the verdicts overridden on any instance are dropped from the in-process tier of this one
+	go dataDict.Listen(redisClient.Subscribe(data_dictionary.InvalidationChannel).Channel())
+	dictionaries, bloomFilters, err := registry.Load(tran, dataDict)
+	if err != nil {
+		panic(err)
+	}
+	// the words found valid since the start are let through the filters
+	bloomFilters.Refresh(context.Background())
+
+	router := chi.NewRouter()
+	router.Use(middleware.Logger)
+	// the counters tell about the deployment, only to the admins
+	administration := admin.New(os.Getenv("ADMIN_TOKEN"), nil, nil)
+	router.With(administration.HttpMiddleware).Handle("/debug/vars", expvar.Handler())
+	router.Mount("/", remote.NewServer(dictionaries))
+
+	port := os.Getenv("DICTIONARY_PORT")
+	if port == "" {
+		port = defaultPort
+	}
+
+	log.Printf("serving the dictionaries on http://localhost:%s/", port)
+	log.Fatal(http.ListenAndServe(":"+port, router))
+}
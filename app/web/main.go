@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"expvar"
 	"log"
 	"net/http"
 	"os"
@@ -19,16 +21,25 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/satriahrh/letter-block/service"
 
+	"github.com/satriahrh/letter-block/data"
 	data_dictionary "github.com/satriahrh/letter-block/data/dictionary"
 	"github.com/satriahrh/letter-block/data/transactional"
 	"github.com/satriahrh/letter-block/dictionary"
-	"github.com/satriahrh/letter-block/dictionary/id_id"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
+	"github.com/satriahrh/letter-block/dictionary/language_pack"
+	"github.com/satriahrh/letter-block/dictionary/registry"
+	"github.com/satriahrh/letter-block/dictionary/remote"
 	"github.com/satriahrh/letter-block/graph"
 	"github.com/satriahrh/letter-block/graph/generated"
+	"github.com/satriahrh/letter-block/middleware/admin"
 	"github.com/satriahrh/letter-block/middleware/auth"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort = "8080"
+	// how often the words of the moves accepted while the dictionary was unavailable are verified
+	verifyInterval = 30 * time.Second
+)
 
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
@@ -53,14 +64,40 @@ func main() {
 	redisClient := redis.NewClient(redisOptions)
 
 	tran := transactional.NewTransactional(db)
-	dataDict := data_dictionary.NewDictionary(72*time.Hour, redisClient)
-	dictionaries := map[string]dictionary.Dictionary{
-		"id-id": id_id.NewIdId(dataDict, http.DefaultClient),
+	// negative verdicts expire sooner, KBBI keeps adding lemma; redis is a hot cache over the lemmas table
+	dataDict := data_dictionary.NewDictionary(7*24*time.Hour, 24*time.Hour, 10000, redisClient).WithStore(tran)
+	// the verdicts overridden on any instance are dropped from the in-process tier of this one
+	go dataDict.Listen(redisClient.Subscribe(data_dictionary.InvalidationChannel).Channel())
+	var dictionaries map[string]dictionary.Dictionary
+	// DICTIONARY_URL points to app/dictionary-server, otherwise the dictionaries are wired here
+	if dictionaryUrl := os.Getenv("DICTIONARY_URL"); dictionaryUrl != "" {
+		// the language packs are still needed for their tiles
+		if dir := os.Getenv("LANGUAGE_PACKS"); dir != "" {
+			if _, err := language_pack.Load(dir); err != nil {
+				panic(err)
+			}
+		}
+		dictionaries = make(map[string]dictionary.Dictionary)
+		for _, dictionaryLanguage := range data.DictionaryLanguages() {
+			// a client per language, an outage of one language does not open the circuit of the others
+			dictionaryClient := http_client.NewClient("dictionary_server_"+dictionaryLanguage, &http.Client{}, http_client.Config{})
+			dictionaries[dictionaryLanguage] = remote.NewRemote(dictionaryUrl, dictionaryLanguage, dictionaryClient)
+		}
+	} else {
+		var bloomFilters *registry.BloomFilters
+		dictionaries, bloomFilters, err = registry.Load(tran, dataDict)
+		if err != nil {
+			panic(err)
+		}
+		// the words found valid since the start are let through the filters
+		bloomFilters.Refresh(context.Background())
 	}
 
 	svc := service.NewService(tran, dictionaries)
 	graphqlResolver := graph.NewResolver(svc)
+	go graphqlResolver.VerifyPendingMoves(context.Background(), verifyInterval)
 	graphqlHandler := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graphqlResolver}))
+	graphqlHandler.SetErrorPresenter(graph.ErrorPresenter)
 
 	graphqlHandler.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
@@ -71,6 +108,7 @@ func main() {
 	})
 
 	authentication := auth.New(tran)
+	administration := admin.New(os.Getenv("ADMIN_TOKEN"), dataDict, svc)
 	router := chi.NewRouter()
 
 	router.Use(middleware.Logger)
@@ -86,9 +124,19 @@ func main() {
 	router.Handle("/",
 		playground.Handler("GraphQL playground", "/graphql"),
 	)
+	// the counters tell about the deployment, only to the admins
+	router.With(administration.HttpMiddleware).Handle("/debug/vars", expvar.Handler())
 	router.HandleFunc("/register", authentication.Register)
 	router.HandleFunc("/authenticate", authentication.Authenticate)
 	router.With(authentication.HttpMiddleware).Handle("/graphql", graphqlHandler)
+	router.Route("/admin", func(r chi.Router) {
+		r.Use(administration.HttpMiddleware)
+		r.Get("/dictionary/stats", administration.DictionaryStats)
+		r.Post("/dictionary/override", administration.DictionaryOverride)
+		r.Post("/dictionary/invalidate", administration.DictionaryInvalidate)
+		r.Get("/dictionary/disputes", administration.Disputes)
+		r.Post("/dictionary/disputes/decide", administration.DisputeDecide)
+	})
 
 	port := os.Getenv("PORT")
 	if port == "" {
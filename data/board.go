@@ -0,0 +1,64 @@
+package data
+
+import (
+	"math/rand"
+	"time"
+)
+
+type TileModifier uint8
+
+const (
+	PLAIN         TileModifier = iota
+	DOUBLE_LETTER TileModifier = iota // doubles the letter points
+	DOUBLE_WORD   TileModifier = iota // doubles the word points
+	LOCK          TileModifier = iota // locks the claimed tile for one round
+	BOMB          TileModifier = iota // resets the neighbouring tiles
+	LOCKED        TileModifier = iota // a claimed LOCK, released on its owner's next turn
+)
+
+var (
+	// number of each modifier placed on a board with bonus tiles
+	modifierDistribution = map[TileModifier]int{
+		DOUBLE_LETTER: 2,
+		DOUBLE_WORD:   2,
+		LOCK:          2,
+		BOMB:          1,
+	}
+)
+
+// NewBoardModifiers scatters the bonus tiles randomly across a board of the given size.
+func NewBoardModifiers(boardSize int) []uint8 {
+	boardModifiers := make([]uint8, boardSize)
+
+	rand.Seed(time.Now().UnixNano())
+	positions := rand.Perm(boardSize)
+	for _, modifier := range []TileModifier{DOUBLE_LETTER, DOUBLE_WORD, LOCK, BOMB} {
+		for i := 0; i < modifierDistribution[modifier] && len(positions) > 0; i++ {
+			boardModifiers[positions[0]] = uint8(modifier)
+			positions = positions[1:]
+		}
+	}
+
+	return boardModifiers
+}
+
+// Neighbours returns the orthogonally adjacent positions on a square board.
+func Neighbours(position uint8, boardWidth uint8) []uint8 {
+	row, column := position/boardWidth, position%boardWidth
+
+	neighbours := make([]uint8, 0, 4)
+	if row > 0 {
+		neighbours = append(neighbours, position-boardWidth)
+	}
+	if row < boardWidth-1 {
+		neighbours = append(neighbours, position+boardWidth)
+	}
+	if column > 0 {
+		neighbours = append(neighbours, position-1)
+	}
+	if column < boardWidth-1 {
+		neighbours = append(neighbours, position+1)
+	}
+
+	return neighbours
+}
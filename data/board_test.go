@@ -0,0 +1,38 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBoardModifiers(t *testing.T) {
+	boardModifiers := data.NewBoardModifiers(25)
+	if assert.Len(t, boardModifiers, 25) {
+		count := make(map[data.TileModifier]int)
+		for _, modifier := range boardModifiers {
+			count[data.TileModifier(modifier)]++
+		}
+		assert.Equal(t, map[data.TileModifier]int{
+			data.PLAIN:         18,
+			data.DOUBLE_LETTER: 2,
+			data.DOUBLE_WORD:   2,
+			data.LOCK:          2,
+			data.BOMB:          1,
+		}, count)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	t.Run("Corner", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint8{1, 5}, data.Neighbours(0, 5))
+		assert.ElementsMatch(t, []uint8{19, 23}, data.Neighbours(24, 5))
+	})
+	t.Run("Edge", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint8{4, 8, 14}, data.Neighbours(9, 5))
+	})
+	t.Run("Middle", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint8{7, 11, 13, 17}, data.Neighbours(12, 5))
+	})
+}
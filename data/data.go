@@ -6,10 +6,16 @@ import (
 	"errors"
 )
 
+// Dictionary caches lemma verdicts and entries. Get tells exist false on a miss,
+// an error is only returned when the cache is unreachable.
 type Dictionary interface {
 	// generateKey(lang, key string) string
-	Get(lang, key string) (result bool, exist bool)
-	Set(lang, key string, value bool)
+	Get(ctx context.Context, lang, key string) (result bool, exist bool, err error)
+	// GetMany is Get of many keys at once, the keys not cached are missing from the results
+	GetMany(ctx context.Context, lang string, keys []string) (results map[string]bool, err error)
+	Set(ctx context.Context, lang, key string, value bool, source string) error
+	GetEntry(ctx context.Context, lang, key string) (entry Entry, exist bool, err error)
+	SetEntry(ctx context.Context, lang, key string, entry Entry) error
 }
 
 // Transactional should satisfying consistency and availability from CAP
@@ -20,20 +26,40 @@ type Transactional interface {
 	InsertGamePlayer(context.Context, *sql.Tx, Game, Player) (Game, error)
 	GetPlayerById(context.Context, PlayerId) (Player, error)
 	GetPlayersByGameId(context.Context, GameId) ([]Player, error)
+	// GetGameById locks the game until the transaction is finalized, when given one
 	GetGameById(context.Context, *sql.Tx, GameId) (Game, error)
 	GetGamePlayersByGameId(context.Context, *sql.Tx, GameId) ([]GamePlayer, error)
 	GetGamesByPlayerId(context.Context, PlayerId) ([]Game, error)
 	LogPlayedWord(context.Context, *sql.Tx, GameId, PlayerId, string) error
 	GetPlayedWordsByGameId(context.Context, GameId) ([]PlayedWord, error)
+	DeletePlayedWord(context.Context, *sql.Tx, GameId, string) error
 	UpdateGame(context.Context, *sql.Tx, Game) error
+	GetPendingGameIds(context.Context) ([]GameId, error)
 	UpsertPlayer(ctx context.Context, tx *sql.Tx, player Player) error
 	GetPlayerByDeviceFingerprint(context.Context, *sql.Tx, DeviceFingerprint) (Player, error)
+	InsertDispute(context.Context, Dispute) (Dispute, error)
+	GetDisputeById(context.Context, *sql.Tx, DisputeId) (Dispute, error)
+	GetDisputesByState(context.Context, DisputeState) ([]Dispute, error)
+	GetPendingDispute(ctx context.Context, language, word string) (dispute Dispute, exist bool, err error)
+	UpdateDispute(context.Context, *sql.Tx, Dispute) error
+	UpsertWordOverride(context.Context, *sql.Tx, WordOverride) error
+	GetWordOverride(ctx context.Context, language, word string) (override WordOverride, exist bool, err error)
+	InsertWordList(context.Context, WordList) (WordList, error)
+	GetWordListById(context.Context, WordListId) (WordList, error)
+	GetWordListsByPlayerId(context.Context, PlayerId) ([]WordList, error)
+	GetLemma(ctx context.Context, language, word string) (lemma Lemma, exist bool, err error)
+	UpsertLemma(context.Context, Lemma) error
+	DeleteLemma(ctx context.Context, language, word string) error
+	GetValidLemmaWords(ctx context.Context, language string) ([]string, error)
+	GetValidOverrideWords(ctx context.Context, language string) ([]string, error)
 }
 
 type PlayerId uint64
 type GameId uint64
 type GamePlayerId uint64
 type DeviceFingerprint string
+type DisputeId uint64
+type WordListId uint64
 
 type Player struct {
 	Id                PlayerId          `json:"id"`
@@ -52,8 +78,108 @@ type Game struct {
 	LetterBank         LetterBank   `json:"letter_bank"`
 	BoardBase          []uint8      `json:"board_base"`
 	BoardPositioning   []uint8      `json:"board_positioning"`
+	BoardModifiers     []uint8      `json:"board_modifiers"`
+	Settings           GameSettings `json:"settings"`
+	Scores             Scores       `json:"scores"` // indexed by player order
+	PreviousState      GameSnapshot `json:"previous_state"`
+	PendingMoves       PendingMoves `json:"pending_moves"`
 }
 
+// GameSnapshot is the game right before its last move, kept so the move can be undone.
+type GameSnapshot struct {
+	PlayerId           PlayerId   `json:"player_id"` // who made the move, zero when there is nothing to undo
+	Word               string     `json:"word"`
+	CurrentPlayerOrder uint8      `json:"current_player_order"`
+	State              GameState  `json:"state"`
+	LetterBank         LetterBank `json:"letter_bank"`
+	BoardBase          []uint8    `json:"board_base"`
+	BoardPositioning   []uint8    `json:"board_positioning"`
+	BoardModifiers     []uint8    `json:"board_modifiers"`
+	Scores             Scores     `json:"scores"`
+	UndoRequested      bool       `json:"undo_requested"`
+	UndoApprovals      []PlayerId `json:"undo_approvals"`
+	Pending            bool       `json:"pending,omitempty"` // the word is still to be verified by the dictionary
+}
+
+// PendingMoves are the snapshots before each move since the oldest one accepted while the dictionary was unavailable,
+// oldest first, so a move found invalid later is rolled back along with the moves made after it.
+type PendingMoves []GameSnapshot
+
+// Move describes what a single turn changed on the board.
+type Move struct {
+	PlayerId     PlayerId          `json:"player_id"`
+	Word         string            `json:"word"`
+	Positions    []uint8           `json:"positions"`
+	Claimed      []uint8           `json:"claimed"`
+	Captured     map[uint8][]uint8 `json:"captured"` // keyed by the previous owner's player order
+	Strengthened []uint8           `json:"strengthened"`
+	Weakened     []uint8           `json:"weakened"`
+	Reset        []uint8           `json:"reset"`       // cleared by a bomb
+	Drawn        []uint8           `json:"drawn"`       // letters drawn from the bank, in word order
+	Pending      bool              `json:"pending"`     // accepted while the dictionary was unavailable, verified later
+	RolledBack   bool              `json:"rolled_back"` // found invalid after being accepted, the game is back to before it
+	Challenged   bool              `json:"challenged"`  // the outcome of a challenge on the word, rolled back when it succeeded
+}
+
+// Entry is what the dictionary tells about a lemma.
+type Entry struct {
+	Lemma       string   `json:"lemma"`
+	Class       string   `json:"class"` // word class, e.g. Nomina
+	Definitions []string `json:"definitions"`
+}
+
+// Lemma is a dictionary verdict kept durably, the redis cache is filled from it.
+type Lemma struct {
+	Language   string `json:"language"` // cache language, e.g. id-id.root
+	Word       string `json:"word"`
+	Valid      bool   `json:"valid"`
+	Source     string `json:"source"` // e.g. kbbi, import or admin
+	FetchedAt  int64  `json:"fetched_at"`
+	Overridden bool   `json:"overridden"` // decided by an admin or imported, a provider never replaces it
+}
+
+// Dispute is a player appeal against the dictionary verdict of a word, decided by a moderator.
+// Decided disputes are kept as the log of the moderation decisions.
+type Dispute struct {
+	Id        DisputeId    `json:"id"`
+	GameId    GameId       `json:"game_id"`
+	PlayerId  PlayerId     `json:"player_id"`
+	Language  string       `json:"language"` // dictionary language, e.g. id-id
+	Word      string       `json:"word"`
+	Valid     bool         `json:"valid"`  // the disputed verdict
+	Source    string       `json:"source"` // the provider deciding the verdict
+	State     DisputeState `json:"state"`
+	Moderator string       `json:"moderator"`
+	CreatedAt int64        `json:"created_at"`
+	DecidedAt int64        `json:"decided_at"`
+}
+
+type DisputeState uint8
+
+const (
+	PENDING  DisputeState = iota
+	APPROVED DisputeState = iota
+	REJECTED DisputeState = iota
+)
+
+// WordOverride is a verdict decided by a moderator, it wins over every dictionary.
+type WordOverride struct {
+	Language  string    `json:"language"`
+	Word      string    `json:"word"`
+	Valid     bool      `json:"valid"`
+	DisputeId DisputeId `json:"dispute_id"`
+}
+
+// WordList is a list of words saved by a player, reusable as the allowed or banned words of their games.
+type WordList struct {
+	Id       WordListId `json:"id"`
+	PlayerId PlayerId   `json:"player_id"`
+	Name     string     `json:"name"`
+	Words    Words      `json:"words"`
+}
+
+type Words []string
+
 type GameState uint8
 
 const (
@@ -62,6 +188,56 @@ const (
 	END     GameState = iota
 )
 
+type GameMode uint8
+
+const (
+	AREA  GameMode = iota // ranked by the number of owned tiles
+	SCORE GameMode = iota // ranked by the points of the played letters
+)
+
+type GameSettings struct {
+	Mode             GameMode   `json:"mode"`
+	BonusTiles       bool       `json:"bonus_tiles"`
+	Language         string     `json:"language"` // tiles language, e.g. id or en
+	HouseRules       HouseRules `json:"house_rules"`
+	ProvisionalTurns bool       `json:"provisional_turns"` // moves are accepted while the dictionary is unavailable, verified later
+	Challenge        bool       `json:"challenge"`         // words are not checked on the turn, the next player may challenge them
+}
+
+// HouseRules are the words a game allows or bans regardless of its dictionary.
+// The words of the referenced lists are copied in when the game is made, editing a list later does not change the game.
+type HouseRules struct {
+	AllowedWords []string     `json:"allowed_words,omitempty"`
+	BannedWords  []string     `json:"banned_words,omitempty"`
+	AllowedLists []WordListId `json:"allowed_lists,omitempty"`
+	BannedLists  []WordListId `json:"banned_lists,omitempty"`
+}
+
+// Verdict tells whether the house rules decide on the word, banning wins over allowing.
+func (rules HouseRules) Verdict(word string) (valid, decided bool) {
+	for _, banned := range rules.BannedWords {
+		if banned == word {
+			return false, true
+		}
+	}
+	for _, allowed := range rules.AllowedWords {
+		if allowed == word {
+			return true, true
+		}
+	}
+	return false, false
+}
+
+// TileLanguage tells the tiles language, games made before languages were selectable are Indonesian.
+func (settings GameSettings) TileLanguage() string {
+	if settings.Language == "" {
+		return DefaultLanguage
+	}
+	return settings.Language
+}
+
+type Scores []uint32
+
 type GamePlayer struct {
 	Id       GamePlayerId `json:"id"`
 	PlayerId PlayerId     `json:"player_id"`
@@ -73,20 +249,45 @@ type PlayedWord struct {
 	Word     string   `json:"word"`
 }
 
+const DefaultLanguage = "id"
+
+// Tiles describes the letters of a language, indexed from one as zero is the blank.
+type Tiles struct {
+	Distribution []int
+	Points       []int // optional, every letter is worth one point when empty
+	Letters      []string
+	Dictionary   string // validates words made of the tiles
+}
+
 var (
-	// utilize yaml
-	tiles = map[string]struct {
-		Distribution []int
-		Letters      string
-	}{
+	tiles = map[string]Tiles{
 		"id": {
 			Distribution: []int{
 				19, 4, 3, 4, 8, 5, 3, 2, 8, 1, 3, 3, 3, 9, 3, 2, 0, 4, 3, 5, 5, 1, 1, 0, 2, 1,
 				// a  b  c  d  e  f  g  h  i  j  k  l  m  n  o  p  q  r  s  t  u  v  w  x  y  z
 			},
-			Letters: " abcdefghijklmnopqrstuvwxyz",
+			Points: []int{
+				1, 3, 4, 3, 1, 2, 4, 5, 1, 8, 4, 4, 4, 1, 4, 5, 0, 3, 4, 2, 2, 8, 8, 0, 5, 8,
+				// a  b  c  d  e  f  g  h  i  j  k  l  m  n  o  p  q  r  s  t  u  v  w  x  y  z
+			},
+			Letters:    splitLetters("abcdefghijklmnopqrstuvwxyz"),
+			Dictionary: "id-id",
+		},
+		"en": {
+			Distribution: []int{
+				9, 2, 2, 4, 12, 2, 3, 2, 9, 1, 1, 4, 2, 6, 8, 2, 1, 6, 4, 6, 4, 2, 2, 1, 2, 1,
+				// a b  c  d  e   f  g  h  i  j  k  l  m  n  o  p  q  r  s  t  u  v  w  x  y  z
+			},
+			Points: []int{
+				1, 3, 3, 2, 1, 4, 2, 4, 1, 8, 5, 1, 3, 1, 1, 3, 10, 1, 1, 1, 1, 4, 4, 8, 4, 10,
+				// a  b  c  d  e  f  g  h  i  j  k  l  m  n  o  p  q   r  s  t  u  v  w  x  y  z
+			},
+			Letters:    splitLetters("abcdefghijklmnopqrstuvwxyz"),
+			Dictionary: "en-us",
 		},
 	}
 
+	ErrorLanguageExist   = errors.New("language exist")
 	ErrorNoLanguageFound = errors.New("no language found")
+	ErrorTilesInvalid    = errors.New("tiles invalid")
 )
@@ -1,44 +1,350 @@
 package dictionary
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-redis/redis"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/satriahrh/letter-block/data"
 )
 
+// localTtl caps how long an instance trusts its in-process copy,
+// so an invalidation missed by Listen, e.g. while reconnecting, is seen soon enough.
+const localTtl = time.Minute
+
+// InvalidationChannel tells the instances which cached verdict was overridden or invalidated,
+// so they drop their in-process copy.
+const InvalidationChannel = "dictionary.invalidation"
+
+// Stats counts the lookups by the tier answering them.
+type Stats struct {
+	LocalHits  uint64 `json:"local_hits"`
+	RemoteHits uint64 `json:"remote_hits"`
+	StoreHits  uint64 `json:"store_hits"`
+	Misses     uint64 `json:"misses"`
+}
+
+// Store keeps the verdicts durably, it is satisfied by data.Transactional
+type Store interface {
+	GetLemma(ctx context.Context, language, word string) (data.Lemma, bool, error)
+	UpsertLemma(ctx context.Context, lemma data.Lemma) error
+	DeleteLemma(ctx context.Context, language, word string) error
+}
+
 type Dictionary struct {
-	ttl    time.Duration
-	client redis.Cmdable
+	localHits  uint64
+	remoteHits uint64
+	storeHits  uint64
+	misses     uint64
+
+	positiveTtl time.Duration
+	negativeTtl time.Duration
+	client      redis.Cmdable
+	local       *lru.Cache
+	store       Store
+}
+
+type localEntry struct {
+	value     bool
+	expiredAt time.Time
 }
 
-func NewDictionary(ttl time.Duration, client redis.Cmdable) *Dictionary {
+// NewDictionary caches verdicts on redis, fronted by an in-process LRU of localSize entries.
+// Non positive localSize disables the in-process tier.
+func NewDictionary(positiveTtl, negativeTtl time.Duration, localSize int, client redis.Cmdable) *Dictionary {
+	var local *lru.Cache
+	if localSize > 0 {
+		local, _ = lru.New(localSize)
+	}
 	return &Dictionary{
-		client: client,
-		ttl:    ttl,
+		positiveTtl: positiveTtl,
+		negativeTtl: negativeTtl,
+		client:      client,
+		local:       local,
 	}
 }
 
+// WithStore writes the verdicts through to the store and looks them up there on a redis miss,
+// so the redis cache can be lost without asking the providers again. The entries stay on redis only.
+func (r *Dictionary) WithStore(store Store) *Dictionary {
+	r.store = store
+	return r
+}
+
 func generateKey(lang, key string) string {
 	return fmt.Sprintf("%v.%v", lang, key)
 }
 
-func (r *Dictionary) Get(lang, key string) (bool, bool) {
+func generateEntryKey(lang, key string) string {
+	return generateKey(lang, key) + entrySuffix
+}
+
+func (r *Dictionary) Get(ctx context.Context, lang, key string) (bool, bool, error) {
 	dictionaryKey := generateKey(lang, key)
+	if value, exist := r.getLocal(dictionaryKey); exist {
+		atomic.AddUint64(&r.localHits, 1)
+		return value, true, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return false, false, err
+	}
 	strCmd := r.client.Get(dictionaryKey)
 	val, err := strCmd.Result()
 
+	if err == nil {
+		atomic.AddUint64(&r.remoteHits, 1)
+		value := val == "@"
+		r.setLocal(dictionaryKey, value, r.ttl(value))
+		return value, true, nil
+	}
+	if err != redis.Nil {
+		if r.store == nil {
+			return false, false, err
+		}
+		log.Printf("dictionary redis failed on %v, using the store: %v", dictionaryKey, err)
+	}
+	if r.store != nil {
+		return r.getStore(ctx, lang, key)
+	}
+
+	atomic.AddUint64(&r.misses, 1)
+	return false, false, nil
+}
+
+// GetMany gets the verdicts of the keys with one MGET for those missing on the local tier,
+// looking the remaining misses up on the store. The keys not cached are missing from the results.
+func (r *Dictionary) GetMany(ctx context.Context, lang string, keys []string) (map[string]bool, error) {
+	results := make(map[string]bool, len(keys))
+	var remoteKeys, dictionaryKeys []string
+	for _, key := range keys {
+		dictionaryKey := generateKey(lang, key)
+		if value, exist := r.getLocal(dictionaryKey); exist {
+			atomic.AddUint64(&r.localHits, 1)
+			results[key] = value
+			continue
+		}
+		remoteKeys = append(remoteKeys, key)
+		dictionaryKeys = append(dictionaryKeys, dictionaryKey)
+	}
+	if len(remoteKeys) == 0 {
+		return results, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	values, err := r.client.MGet(dictionaryKeys...).Result()
 	if err != nil {
-		return false, false
+		if r.store == nil {
+			return nil, err
+		}
+		log.Printf("dictionary redis failed on %v keys of %v, using the store: %v", len(dictionaryKeys), lang, err)
+		values = make([]interface{}, len(dictionaryKeys))
+	}
+
+	for i, key := range remoteKeys {
+		if val, ok := values[i].(string); ok {
+			atomic.AddUint64(&r.remoteHits, 1)
+			value := val == "@"
+			r.setLocal(dictionaryKeys[i], value, r.ttl(value))
+			results[key] = value
+			continue
+		}
+		if r.store == nil {
+			atomic.AddUint64(&r.misses, 1)
+			continue
+		}
+		value, exist, err := r.getStore(ctx, lang, key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			results[key] = value
+		}
+	}
+	return results, nil
+}
+
+// getStore looks the verdict up on the store, filling redis back when found.
+// A negative verdict older than the negative ttl is missed, as the provider may know the word by now.
+func (r *Dictionary) getStore(ctx context.Context, lang, key string) (bool, bool, error) {
+	lemma, exist, err := r.store.GetLemma(ctx, lang, key)
+	if err != nil {
+		return false, false, err
+	}
+	stale := !lemma.Valid && !lemma.Overridden && time.Since(time.Unix(lemma.FetchedAt, 0)) > r.negativeTtl
+	if !exist || stale {
+		atomic.AddUint64(&r.misses, 1)
+		return false, false, nil
+	}
+
+	atomic.AddUint64(&r.storeHits, 1)
+	ttl := r.ttl(lemma.Valid)
+	if lemma.Overridden {
+		ttl = 0
+	}
+	if err := r.set(lang, key, lemma.Valid, ttl); err != nil {
+		log.Printf("dictionary redis failed on %v: %v", generateKey(lang, key), err)
+	}
+	return lemma.Valid, true, nil
+}
+
+// Set caches the verdict told by the source, e.g. kbbi.
+func (r *Dictionary) Set(ctx context.Context, lang, key string, value bool, source string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := r.upsertStore(ctx, lang, key, value, source, false); err != nil {
+		return err
+	}
+	return r.set(lang, key, value, r.ttl(value))
+}
+
+func (r *Dictionary) GetEntry(ctx context.Context, lang, key string) (entry data.Entry, exist bool, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	val, err := r.client.Get(generateEntryKey(lang, key)).Result()
+	if err == redis.Nil {
+		return entry, false, nil
+	} else if err != nil {
+		return
 	}
 
-	return val == "@", true
+	err = json.Unmarshal([]byte(val), &entry)
+	if err != nil {
+		return
+	}
+	return entry, true, nil
+}
+
+// SetEntry keeps the entry as long as a positive verdict.
+func (r *Dictionary) SetEntry(ctx context.Context, lang, key string, entry data.Entry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	val, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(generateEntryKey(lang, key), string(val), r.positiveTtl).Err()
+}
+
+// Override replaces the cached verdict of the word without expiration, for when the provider got it wrong.
+func (r *Dictionary) Override(lang, key string, value bool) error {
+	if err := r.upsertStore(context.Background(), lang, key, value, "admin", true); err != nil {
+		return err
+	}
+	if err := r.set(lang, key, value, 0); err != nil {
+		return err
+	}
+	r.publish(generateKey(lang, key))
+	return nil
+}
+
+// Invalidate forgets the cached verdict of the word, so it is looked up again.
+func (r *Dictionary) Invalidate(lang, key string) error {
+	dictionaryKey := generateKey(lang, key)
+	if r.local != nil {
+		r.local.Remove(dictionaryKey)
+	}
+	if r.store != nil {
+		if err := r.store.DeleteLemma(context.Background(), lang, key); err != nil {
+			return err
+		}
+	}
+	if err := r.client.Del(dictionaryKey, generateEntryKey(lang, key)).Err(); err != nil {
+		return err
+	}
+	r.publish(dictionaryKey)
+	return nil
+}
+
+// Listen drops the in-process copies overridden or invalidated by any instance until the messages are closed,
+// e.g. those of redis.Client.Subscribe(InvalidationChannel).Channel().
+func (r *Dictionary) Listen(messages <-chan *redis.Message) {
+	for message := range messages {
+		if r.local != nil {
+			r.local.Remove(message.Payload)
+		}
+	}
+}
+
+// publish tells the other instances to drop their in-process copy, the verdict is already changed on redis.
+func (r *Dictionary) publish(dictionaryKey string) {
+	if err := r.client.Publish(InvalidationChannel, dictionaryKey).Err(); err != nil {
+		log.Printf("dictionary redis failed to publish the invalidation of %v: %v", dictionaryKey, err)
+	}
 }
 
-func (r *Dictionary) Set(lang, key string, value bool) {
+func (r *Dictionary) Stats() Stats {
+	return Stats{
+		LocalHits:  atomic.LoadUint64(&r.localHits),
+		RemoteHits: atomic.LoadUint64(&r.remoteHits),
+		StoreHits:  atomic.LoadUint64(&r.storeHits),
+		Misses:     atomic.LoadUint64(&r.misses),
+	}
+}
+
+func (r *Dictionary) upsertStore(ctx context.Context, lang, key string, value bool, source string, overridden bool) error {
+	if r.store == nil {
+		return nil
+	}
+	return r.store.UpsertLemma(ctx, data.Lemma{
+		Language:   lang,
+		Word:       key,
+		Valid:      value,
+		Source:     source,
+		FetchedAt:  time.Now().Unix(),
+		Overridden: overridden,
+	})
+}
+
+func (r *Dictionary) set(lang, key string, value bool, ttl time.Duration) error {
 	val := "0"
 	if value {
 		val = "@"
 	}
-	r.client.Set(generateKey(lang, key), val, 7*24*time.Hour)
+	dictionaryKey := generateKey(lang, key)
+	r.setLocal(dictionaryKey, value, ttl)
+	return r.client.Set(dictionaryKey, val, ttl).Err()
+}
+
+func (r *Dictionary) ttl(value bool) time.Duration {
+	if value {
+		return r.positiveTtl
+	}
+	return r.negativeTtl
+}
+
+func (r *Dictionary) getLocal(dictionaryKey string) (bool, bool) {
+	if r.local == nil {
+		return false, false
+	}
+	raw, exist := r.local.Get(dictionaryKey)
+	if !exist {
+		return false, false
+	}
+	entry := raw.(localEntry)
+	if time.Now().After(entry.expiredAt) {
+		r.local.Remove(dictionaryKey)
+		return false, false
+	}
+	return entry.value, true
+}
+
+func (r *Dictionary) setLocal(dictionaryKey string, value bool, ttl time.Duration) {
+	if r.local == nil {
+		return
+	}
+	if ttl <= 0 || ttl > localTtl {
+		ttl = localTtl
+	}
+	r.local.Add(dictionaryKey, localEntry{value, time.Now().Add(ttl)})
 }
@@ -1,8 +1,10 @@
 package dictionary_test
 
 import (
+	"github.com/satriahrh/letter-block/data"
 	"github.com/satriahrh/letter-block/data/dictionary"
 
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -12,9 +14,17 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var ctx = context.Background()
+
 func suiteDictionary() (dict *dictionary.Dictionary, clientMock *redismock.ClientMock) {
 	clientMock = redismock.NewMock()
-	dict = dictionary.NewDictionary(time.Hour, clientMock)
+	dict = dictionary.NewDictionary(7*24*time.Hour, 24*time.Hour, 0, clientMock)
+	return
+}
+
+func suiteLocalDictionary() (dict *dictionary.Dictionary, clientMock *redismock.ClientMock) {
+	clientMock = redismock.NewMock()
+	dict = dictionary.NewDictionary(7*24*time.Hour, 24*time.Hour, 16, clientMock)
 	return
 }
 
@@ -30,8 +40,9 @@ func TestDictionary_DictionaryGet(t *testing.T) {
 			On("Get", dictionaryKey).
 			Return(redis.NewStringResult("@", nil))
 
-		result, exist := dict.Get(lang, key)
+		result, exist, err := dict.Get(ctx, lang, key)
 
+		assert.NoError(t, err)
 		assert.True(t, result)
 		assert.True(t, exist)
 	})
@@ -42,23 +53,46 @@ func TestDictionary_DictionaryGet(t *testing.T) {
 			On("Get", dictionaryKey).
 			Return(redis.NewStringResult("0", nil))
 
-		result, exist := dict.Get(lang, key)
+		result, exist, err := dict.Get(ctx, lang, key)
 
+		assert.NoError(t, err)
 		assert.False(t, result, "invalid")
 		assert.True(t, exist, "exist")
 	})
-	t.Run("UnexpectedErrorOrNotExisted", func(t *testing.T) {
+	t.Run("NotExisted", func(t *testing.T) {
 		dict, clientMock := suiteDictionary()
 
 		clientMock.
 			On("Get", dictionaryKey).
-			Return(redis.NewStringResult("", errors.New("something")))
+			Return(redis.NewStringResult("", redis.Nil))
 
-		result, exist := dict.Get(lang, key)
+		result, exist, err := dict.Get(ctx, lang, key)
 
+		assert.NoError(t, err)
 		assert.False(t, result, "invalid")
 		assert.False(t, exist, "not existed")
 	})
+	t.Run("UnexpectedError", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Get", dictionaryKey).
+			Return(redis.NewStringResult("", errors.New("something")))
+
+		_, exist, err := dict.Get(ctx, lang, key)
+
+		assert.EqualError(t, err, "something")
+		assert.False(t, exist, "not existed")
+	})
+	t.Run("ContextCanceled", func(t *testing.T) {
+		dict, _ := suiteDictionary()
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, _, err := dict.Get(canceledCtx, lang, key)
+
+		assert.EqualError(t, err, context.Canceled.Error())
+	})
 }
 
 func TestDictionary_DictionarySet(t *testing.T) {
@@ -73,15 +107,261 @@ func TestDictionary_DictionarySet(t *testing.T) {
 			On("Set", dictionaryKey, "@", 7*24*time.Hour).
 			Return(redis.NewStatusResult("@", nil))
 
-		dict.Set(lang, key, true)
+		assert.NoError(t, dict.Set(ctx, lang, key, true, "kbbi"))
 	})
 	t.Run("Invalid", func(t *testing.T) {
 		dict, clientMock := suiteDictionary()
 
 		clientMock.
-			On("Set", dictionaryKey, "0", 7*24*time.Hour).
+			On("Set", dictionaryKey, "0", 24*time.Hour).
 			Return(redis.NewStatusResult("0", nil))
 
-		dict.Set(lang, key, false)
+		assert.NoError(t, dict.Set(ctx, lang, key, false, "kbbi"))
+	})
+}
+
+func TestDictionary_LocalTier(t *testing.T) {
+	lang := "id-id"
+	key := "word"
+	dictionaryKey := "id-id.word"
+
+	t.Run("HitAfterRemote", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Get", dictionaryKey).
+			Return(redis.NewStringResult("@", nil)).Once()
+
+		for i := 0; i < 3; i++ {
+			result, exist, err := dict.Get(ctx, lang, key)
+			assert.NoError(t, err)
+			assert.True(t, result)
+			assert.True(t, exist)
+		}
+
+		clientMock.AssertNumberOfCalls(t, "Get", 1)
+		assert.Equal(t, dictionary.Stats{LocalHits: 2, RemoteHits: 1}, dict.Stats())
+	})
+	t.Run("HitAfterSet", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Set", dictionaryKey, "0", 24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+
+		assert.NoError(t, dict.Set(ctx, lang, key, false, "kbbi"))
+		result, exist, err := dict.Get(ctx, lang, key)
+
+		assert.NoError(t, err)
+		assert.False(t, result)
+		assert.True(t, exist)
+		assert.Equal(t, dictionary.Stats{LocalHits: 1}, dict.Stats())
+	})
+	t.Run("Miss", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Get", dictionaryKey).
+			Return(redis.NewStringResult("", redis.Nil))
+
+		_, exist, err := dict.Get(ctx, lang, key)
+
+		assert.NoError(t, err)
+
+		assert.False(t, exist)
+		assert.Equal(t, dictionary.Stats{Misses: 1}, dict.Stats())
+	})
+}
+
+func TestDictionary_GetMany(t *testing.T) {
+	lang := "id-id"
+
+	t.Run("Success", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Set", "id-id.makan", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+		clientMock.
+			On("MGet", []string{"id-id.mkn", "id-id.hilang"}).
+			Return(redis.NewSliceResult([]interface{}{"0", nil}, nil))
+
+		assert.NoError(t, dict.Set(ctx, lang, "makan", true, "kbbi"))
+		results, err := dict.GetMany(ctx, lang, []string{"makan", "mkn", "hilang"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"makan": true, "mkn": false}, results)
+			assert.Equal(t, dictionary.Stats{LocalHits: 1, RemoteHits: 1, Misses: 1}, dict.Stats())
+		}
+	})
+	t.Run("AllLocal", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Set", "id-id.makan", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+
+		assert.NoError(t, dict.Set(ctx, lang, "makan", true, "kbbi"))
+		results, err := dict.GetMany(ctx, lang, []string{"makan"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"makan": true}, results)
+		}
+		clientMock.AssertNotCalled(t, "MGet", []string{"id-id.makan"})
+	})
+	t.Run("UnexpectedError", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("MGet", []string{"id-id.makan"}).
+			Return(redis.NewSliceResult(nil, errors.New("something")))
+
+		_, err := dict.GetMany(ctx, lang, []string{"makan"})
+		assert.EqualError(t, err, "something")
+	})
+}
+
+func TestDictionary_Override(t *testing.T) {
+	dict, clientMock := suiteLocalDictionary()
+
+	clientMock.
+		On("Set", "id-id.word", "@", time.Duration(0)).
+		Return(redis.NewStatusResult("OK", nil))
+	clientMock.
+		On("Publish", dictionary.InvalidationChannel, "id-id.word").
+		Return(redis.NewIntResult(1, nil)).Once()
+
+	if assert.NoError(t, dict.Override("id-id", "word", true)) {
+		result, exist, _ := dict.Get(ctx, "id-id", "word")
+		assert.True(t, result)
+		assert.True(t, exist)
+	}
+	clientMock.AssertExpectations(t)
+}
+
+func TestDictionary_Invalidate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Set", "id-id.word", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+		clientMock.
+			On("Del", []string{"id-id.word", "id-id.word#entry"}).
+			Return(redis.NewIntResult(1, nil))
+		clientMock.
+			On("Get", "id-id.word").
+			Return(redis.NewStringResult("", redis.Nil))
+		clientMock.
+			On("Publish", dictionary.InvalidationChannel, "id-id.word").
+			Return(redis.NewIntResult(1, nil)).Once()
+
+		assert.NoError(t, dict.Set(ctx, "id-id", "word", true, "kbbi"))
+		if assert.NoError(t, dict.Invalidate("id-id", "word")) {
+			_, exist, _ := dict.Get(ctx, "id-id", "word")
+			assert.False(t, exist, "forgotten on both tiers")
+		}
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("ErrorPublish", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Del", []string{"id-id.word", "id-id.word#entry"}).
+			Return(redis.NewIntResult(1, nil))
+		clientMock.
+			On("Publish", dictionary.InvalidationChannel, "id-id.word").
+			Return(redis.NewIntResult(0, errors.New("something")))
+
+		assert.NoError(t, dict.Invalidate("id-id", "word"), "the other instances see it within the local ttl")
 	})
+	t.Run("Error", func(t *testing.T) {
+		dict, clientMock := suiteLocalDictionary()
+
+		clientMock.
+			On("Del", []string{"id-id.word", "id-id.word#entry"}).
+			Return(redis.NewIntResult(0, errors.New("something")))
+
+		assert.EqualError(t, dict.Invalidate("id-id", "word"), "something")
+	})
+}
+
+func TestDictionary_Listen(t *testing.T) {
+	dict, clientMock := suiteLocalDictionary()
+
+	clientMock.
+		On("Get", "id-id.word").
+		Return(redis.NewStringResult("0", nil)).Once()
+	clientMock.
+		On("Get", "id-id.word").
+		Return(redis.NewStringResult("@", nil)).Once()
+
+	result, _, _ := dict.Get(ctx, "id-id", "word")
+	assert.False(t, result)
+
+	// overridden on another instance
+	messages := make(chan *redis.Message, 1)
+	messages <- &redis.Message{Channel: dictionary.InvalidationChannel, Payload: "id-id.word"}
+	close(messages)
+	dict.Listen(messages)
+
+	result, _, _ = dict.Get(ctx, "id-id", "word")
+	assert.True(t, result, "the in-process copy is dropped")
+	clientMock.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestDictionary_GetEntry(t *testing.T) {
+	t.Run("Exist", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Get", "id-id.word#entry").
+			Return(redis.NewStringResult(`{"lemma":"word","class":"Nomina","definitions":["kata"]}`, nil))
+
+		entry, exist, err := dict.GetEntry(ctx, "id-id", "word")
+		if assert.NoError(t, err) {
+			assert.True(t, exist)
+			assert.Equal(t, data.Entry{Lemma: "word", Class: "Nomina", Definitions: []string{"kata"}}, entry)
+		}
+	})
+	t.Run("NotExisted", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Get", "id-id.word#entry").
+			Return(redis.NewStringResult("", redis.Nil))
+
+		_, exist, err := dict.GetEntry(ctx, "id-id", "word")
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+	t.Run("ErrorUnmarshal", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Get", "id-id.word#entry").
+			Return(redis.NewStringResult("{", nil))
+
+		_, _, err := dict.GetEntry(ctx, "id-id", "word")
+		assert.Error(t, err)
+	})
+	t.Run("UnexpectedError", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Get", "id-id.word#entry").
+			Return(redis.NewStringResult("", errors.New("something")))
+
+		_, _, err := dict.GetEntry(ctx, "id-id", "word")
+		assert.EqualError(t, err, "something")
+	})
+}
+
+func TestDictionary_SetEntry(t *testing.T) {
+	dict, clientMock := suiteDictionary()
+
+	clientMock.
+		On("Set", "id-id.word#entry", `{"lemma":"word","class":"Nomina","definitions":["kata"]}`, 7*24*time.Hour).
+		Return(redis.NewStatusResult("OK", nil))
+
+	err := dict.SetEntry(ctx, "id-id", "word", data.Entry{Lemma: "word", Class: "Nomina", Definitions: []string{"kata"}})
+	assert.NoError(t, err)
 }
@@ -0,0 +1,113 @@
+package dictionary
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+const (
+	entrySuffix = "#entry"
+	scanCount   = 1000
+)
+
+// Record is a cached verdict with its entry, as exported and imported in bulk.
+type Record struct {
+	Language string      `json:"language"`
+	Word     string      `json:"word"`
+	Valid    bool        `json:"valid"`
+	Source   string      `json:"source,omitempty"`
+	Entry    *data.Entry `json:"entry,omitempty"`
+}
+
+// Records walks the cached verdicts of the language, or of every language when empty.
+// The cache may change while walking, a verdict may be missed or seen twice.
+func (r *Dictionary) Records(ctx context.Context, language string, fn func(Record) error) error {
+	match := "*"
+	if language != "" {
+		match = language + ".*"
+	}
+
+	var cursor uint64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		keys, nextCursor, err := r.client.Scan(cursor, match, scanCount).Result()
+		if err != nil {
+			return err
+		}
+
+		var records []Record
+		var recordKeys []string
+		for _, key := range keys {
+			if strings.HasSuffix(key, entrySuffix) {
+				continue
+			}
+			// the word never has a dot, unlike the language, e.g. id-id.root
+			i := strings.LastIndex(key, ".")
+			if i < 0 || language != "" && key[:i] != language {
+				continue
+			}
+			records = append(records, Record{Language: key[:i], Word: key[i+1:]})
+			recordKeys = append(recordKeys, key, key+entrySuffix)
+		}
+
+		if len(recordKeys) > 0 {
+			values, err := r.client.MGet(recordKeys...).Result()
+			if err != nil {
+				return err
+			}
+			for i, record := range records {
+				verdict, ok := values[2*i].(string)
+				if !ok {
+					// expired since scanned
+					continue
+				}
+				record.Valid = verdict == "@"
+				if entry, ok := values[2*i+1].(string); ok {
+					record.Entry = &data.Entry{}
+					if err := json.Unmarshal([]byte(entry), record.Entry); err != nil {
+						return err
+					}
+				}
+				if err := fn(record); err != nil {
+					return err
+				}
+			}
+		}
+
+		cursor = nextCursor
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+// Put caches the record, without expiration when permanent, otherwise as long as its verdict would be.
+// A permanent record is overridden on the store, a provider never replaces it.
+func (r *Dictionary) Put(ctx context.Context, record Record, permanent bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := r.upsertStore(ctx, record.Language, record.Word, record.Valid, record.Source, permanent); err != nil {
+		return err
+	}
+	ttl := r.ttl(record.Valid)
+	if permanent {
+		ttl = 0
+	}
+	if err := r.set(record.Language, record.Word, record.Valid, ttl); err != nil {
+		return err
+	}
+	if record.Entry == nil {
+		return nil
+	}
+	val, err := json.Marshal(record.Entry)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(generateEntryKey(record.Language, record.Word), string(val), ttl).Err()
+}
@@ -0,0 +1,147 @@
+package dictionary_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionary_Records(t *testing.T) {
+	t.Run("Language", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Scan", uint64(0), "id-id.*", int64(1000)).
+			Return(redis.NewScanCmdResult([]string{"id-id.makan", "id-id.makan#entry", "id-id.root.makan"}, 7, nil))
+		clientMock.
+			On("Scan", uint64(7), "id-id.*", int64(1000)).
+			Return(redis.NewScanCmdResult([]string{"id-id.mkn", "id-id.hilang"}, 0, nil))
+		clientMock.
+			On("MGet", []string{"id-id.makan", "id-id.makan#entry"}).
+			Return(redis.NewSliceResult([]interface{}{"@", `{"lemma":"makan","class":"Verba","definitions":["memasukkan makanan"]}`}, nil))
+		clientMock.
+			On("MGet", []string{"id-id.mkn", "id-id.mkn#entry", "id-id.hilang", "id-id.hilang#entry"}).
+			Return(redis.NewSliceResult([]interface{}{"0", nil, nil, nil}, nil))
+
+		var records []dictionary.Record
+		err := dict.Records(ctx, "id-id", func(record dictionary.Record) error {
+			records = append(records, record)
+			return nil
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []dictionary.Record{
+				{Language: "id-id", Word: "makan", Valid: true, Entry: &data.Entry{
+					Lemma: "makan", Class: "Verba", Definitions: []string{"memasukkan makanan"},
+				}},
+				{Language: "id-id", Word: "mkn", Valid: false},
+			}, records)
+		}
+	})
+	t.Run("EveryLanguage", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Scan", uint64(0), "*", int64(1000)).
+			Return(redis.NewScanCmdResult([]string{"id-id.root.makan"}, 0, nil))
+		clientMock.
+			On("MGet", []string{"id-id.root.makan", "id-id.root.makan#entry"}).
+			Return(redis.NewSliceResult([]interface{}{"@", nil}, nil))
+
+		var records []dictionary.Record
+		err := dict.Records(ctx, "", func(record dictionary.Record) error {
+			records = append(records, record)
+			return nil
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []dictionary.Record{{Language: "id-id.root", Word: "makan", Valid: true}}, records)
+		}
+	})
+	t.Run("ErrorScan", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		unexpectedError := errors.New("unexpected error")
+		clientMock.
+			On("Scan", uint64(0), "*", int64(1000)).
+			Return(redis.NewScanCmdResult(nil, 0, unexpectedError))
+
+		err := dict.Records(ctx, "", func(record dictionary.Record) error {
+			return nil
+		})
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorFn", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Scan", uint64(0), "*", int64(1000)).
+			Return(redis.NewScanCmdResult([]string{"id-id.makan"}, 0, nil))
+		clientMock.
+			On("MGet", []string{"id-id.makan", "id-id.makan#entry"}).
+			Return(redis.NewSliceResult([]interface{}{"@", nil}, nil))
+
+		unexpectedError := errors.New("unexpected error")
+		err := dict.Records(ctx, "", func(record dictionary.Record) error {
+			return unexpectedError
+		})
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorContext", func(t *testing.T) {
+		dict, _ := suiteDictionary()
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := dict.Records(canceledCtx, "", func(record dictionary.Record) error {
+			return nil
+		})
+		assert.EqualError(t, err, context.Canceled.Error())
+	})
+}
+
+func TestDictionary_Put(t *testing.T) {
+	t.Run("Permanent", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Set", "id-id.makan", "@", time.Duration(0)).
+			Return(redis.NewStatusResult("", nil))
+		clientMock.
+			On("Set", "id-id.makan#entry", `{"lemma":"makan","class":"","definitions":null}`, time.Duration(0)).
+			Return(redis.NewStatusResult("", nil))
+
+		err := dict.Put(ctx, dictionary.Record{
+			Language: "id-id", Word: "makan", Valid: true, Entry: &data.Entry{Lemma: "makan"},
+		}, true)
+		assert.NoError(t, err)
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("Expiring", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		clientMock.
+			On("Set", "id-id.mkn", "0", 24*time.Hour).
+			Return(redis.NewStatusResult("", nil))
+
+		err := dict.Put(ctx, dictionary.Record{Language: "id-id", Word: "mkn"}, false)
+		assert.NoError(t, err)
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("ErrorSet", func(t *testing.T) {
+		dict, clientMock := suiteDictionary()
+
+		unexpectedError := errors.New("unexpected error")
+		clientMock.
+			On("Set", "id-id.makan", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("", unexpectedError))
+
+		err := dict.Put(ctx, dictionary.Record{
+			Language: "id-id", Word: "makan", Valid: true, Entry: &data.Entry{Lemma: "makan"},
+		}, false)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+}
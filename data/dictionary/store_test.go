@@ -0,0 +1,234 @@
+package dictionary_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type Store struct {
+	mock.Mock
+}
+
+func (s *Store) GetLemma(ctx context.Context, language, word string) (data.Lemma, bool, error) {
+	args := s.Called(language, word)
+	return args.Get(0).(data.Lemma), args.Bool(1), args.Error(2)
+}
+
+func (s *Store) UpsertLemma(ctx context.Context, lemma data.Lemma) error {
+	return s.Called(lemma).Error(0)
+}
+
+func (s *Store) DeleteLemma(ctx context.Context, language, word string) error {
+	return s.Called(language, word).Error(0)
+}
+
+func suiteStoreDictionary() (dict *dictionary.Dictionary, clientMock *redismock.ClientMock, store *Store) {
+	dict, clientMock = suiteDictionary()
+	store = &Store{}
+	dict = dict.WithStore(store)
+	return
+}
+
+func lemmaMatcher(expected data.Lemma) interface{} {
+	return mock.MatchedBy(func(lemma data.Lemma) bool {
+		fetchedAt := lemma.FetchedAt
+		lemma.FetchedAt = 0
+		return lemma == expected && time.Since(time.Unix(fetchedAt, 0)) < time.Minute
+	})
+}
+
+func TestDictionary_StoreGet(t *testing.T) {
+	t.Run("FilledFromStore", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("Get", "id-id.word").
+			Return(redis.NewStringResult("", redis.Nil))
+		store.On("GetLemma", "id-id", "word").
+			Return(data.Lemma{Language: "id-id", Word: "word", Valid: true, FetchedAt: 1}, true, nil)
+		clientMock.
+			On("Set", "id-id.word", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+
+		result, exist, err := dict.Get(ctx, "id-id", "word")
+		if assert.NoError(t, err) {
+			assert.True(t, result)
+			assert.True(t, exist)
+			assert.Equal(t, uint64(1), dict.Stats().StoreHits)
+		}
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("RedisFailed", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("Get", "id-id.word").
+			Return(redis.NewStringResult("", errors.New("connection refused")))
+		store.On("GetLemma", "id-id", "word").
+			Return(data.Lemma{Language: "id-id", Word: "word", Overridden: true}, true, nil)
+		clientMock.
+			On("Set", "id-id.word", "0", time.Duration(0)).
+			Return(redis.NewStatusResult("", errors.New("connection refused")))
+
+		result, exist, err := dict.Get(ctx, "id-id", "word")
+		if assert.NoError(t, err) {
+			assert.False(t, result)
+			assert.True(t, exist)
+		}
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("StaleNegative", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("Get", "id-id.word").
+			Return(redis.NewStringResult("", redis.Nil))
+		store.On("GetLemma", "id-id", "word").
+			Return(data.Lemma{Language: "id-id", Word: "word", FetchedAt: time.Now().Add(-48 * time.Hour).Unix()}, true, nil)
+
+		_, exist, err := dict.Get(ctx, "id-id", "word")
+		if assert.NoError(t, err) {
+			assert.False(t, exist, "the provider is asked again")
+			assert.Equal(t, uint64(1), dict.Stats().Misses)
+		}
+	})
+	t.Run("ErrorGetLemma", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("Get", "id-id.word").
+			Return(redis.NewStringResult("", redis.Nil))
+		store.On("GetLemma", "id-id", "word").
+			Return(data.Lemma{}, false, errors.New("unexpected error"))
+
+		_, _, err := dict.Get(ctx, "id-id", "word")
+		assert.EqualError(t, err, "unexpected error")
+	})
+}
+
+func TestDictionary_StoreGetMany(t *testing.T) {
+	t.Run("MissesFromStore", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("MGet", []string{"id-id.makan", "id-id.minum", "id-id.hilang"}).
+			Return(redis.NewSliceResult([]interface{}{"@", nil, nil}, nil))
+		store.On("GetLemma", "id-id", "minum").
+			Return(data.Lemma{Language: "id-id", Word: "minum", Valid: true, FetchedAt: 1}, true, nil)
+		store.On("GetLemma", "id-id", "hilang").
+			Return(data.Lemma{}, false, nil)
+		clientMock.
+			On("Set", "id-id.minum", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+
+		results, err := dict.GetMany(ctx, "id-id", []string{"makan", "minum", "hilang"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"makan": true, "minum": true}, results)
+			assert.Equal(t, dictionary.Stats{RemoteHits: 1, StoreHits: 1, Misses: 1}, dict.Stats())
+		}
+	})
+	t.Run("RedisFailed", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("MGet", []string{"id-id.makan"}).
+			Return(redis.NewSliceResult(nil, errors.New("connection refused")))
+		store.On("GetLemma", "id-id", "makan").
+			Return(data.Lemma{Language: "id-id", Word: "makan", Overridden: true}, true, nil)
+		clientMock.
+			On("Set", "id-id.makan", "0", time.Duration(0)).
+			Return(redis.NewStatusResult("", errors.New("connection refused")))
+
+		results, err := dict.GetMany(ctx, "id-id", []string{"makan"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"makan": false}, results)
+		}
+	})
+	t.Run("ErrorGetLemma", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		clientMock.
+			On("MGet", []string{"id-id.makan"}).
+			Return(redis.NewSliceResult([]interface{}{nil}, nil))
+		store.On("GetLemma", "id-id", "makan").
+			Return(data.Lemma{}, false, errors.New("unexpected error"))
+
+		_, err := dict.GetMany(ctx, "id-id", []string{"makan"})
+		assert.EqualError(t, err, "unexpected error")
+	})
+}
+
+func TestDictionary_StoreWrite(t *testing.T) {
+	t.Run("Set", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		store.On("UpsertLemma", lemmaMatcher(data.Lemma{Language: "id-id", Word: "word", Valid: true, Source: "kbbi"})).
+			Return(nil)
+		clientMock.
+			On("Set", "id-id.word", "@", 7*24*time.Hour).
+			Return(redis.NewStatusResult("OK", nil))
+
+		assert.NoError(t, dict.Set(ctx, "id-id", "word", true, "kbbi"))
+		store.AssertExpectations(t)
+	})
+	t.Run("ErrorUpsertLemma", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		store.On("UpsertLemma", mock.Anything).
+			Return(errors.New("unexpected error"))
+
+		assert.EqualError(t, dict.Set(ctx, "id-id", "word", true, "kbbi"), "unexpected error")
+		clientMock.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Override", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		store.On("UpsertLemma", lemmaMatcher(data.Lemma{Language: "id-id", Word: "word", Source: "admin", Overridden: true})).
+			Return(nil)
+		clientMock.
+			On("Set", "id-id.word", "0", time.Duration(0)).
+			Return(redis.NewStatusResult("OK", nil))
+		clientMock.
+			On("Publish", dictionary.InvalidationChannel, "id-id.word").
+			Return(redis.NewIntResult(1, nil))
+
+		assert.NoError(t, dict.Override("id-id", "word", false))
+		store.AssertExpectations(t)
+	})
+	t.Run("Invalidate", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		store.On("DeleteLemma", "id-id", "word").
+			Return(nil)
+		clientMock.
+			On("Del", []string{"id-id.word", "id-id.word#entry"}).
+			Return(redis.NewIntResult(1, nil))
+		clientMock.
+			On("Publish", dictionary.InvalidationChannel, "id-id.word").
+			Return(redis.NewIntResult(1, nil))
+
+		assert.NoError(t, dict.Invalidate("id-id", "word"))
+		store.AssertExpectations(t)
+	})
+	t.Run("PutPermanent", func(t *testing.T) {
+		dict, clientMock, store := suiteStoreDictionary()
+
+		store.On("UpsertLemma", lemmaMatcher(data.Lemma{Language: "id-id", Word: "word", Valid: true, Source: "import", Overridden: true})).
+			Return(nil)
+		clientMock.
+			On("Set", "id-id.word", "@", time.Duration(0)).
+			Return(redis.NewStatusResult("OK", nil))
+
+		assert.NoError(t, dict.Put(ctx, dictionary.Record{Language: "id-id", Word: "word", Valid: true, Source: "import"}, true))
+		store.AssertExpectations(t)
+	})
+}
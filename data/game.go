@@ -0,0 +1,169 @@
+package data
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+const (
+	MaxStrength = 2
+)
+
+var (
+	ErrorUnscannable = errors.New("unscannable value")
+)
+
+// PositioningSpace is the base used to pack the owner and the strength of a tile
+// into BoardPositioning, as owner + (strength-1)*PositioningSpace.
+func (game Game) PositioningSpace() uint8 {
+	return game.NumberOfPlayer + 1
+}
+
+// TileOwner returns the order of the player owning the tile plus one, zero when it is vacant.
+func (game Game) TileOwner(position uint8) uint8 {
+	return game.BoardPositioning[position] % game.PositioningSpace()
+}
+
+// TileStrength returns the strength of the owner on the tile, zero when it is vacant.
+func (game Game) TileStrength(position uint8) uint8 {
+	if game.BoardPositioning[position] == 0 {
+		return 0
+	}
+	return game.BoardPositioning[position]/game.PositioningSpace() + 1
+}
+
+// TileDefended tells whether an opponent could not capture the tile on their next move.
+func (game Game) TileDefended(position uint8) bool {
+	if int(position) < len(game.BoardModifiers) && TileModifier(game.BoardModifiers[position]) == LOCKED {
+		return game.TileOwner(position) > 0
+	}
+	return game.TileStrength(position) >= MaxStrength
+}
+
+// Ranking returns player orders sorted from the leading player. On SCORE mode the
+// players are ranked by their points, otherwise by the number of tiles they own.
+func (game Game) Ranking() []uint8 {
+	standing := make([]uint32, game.NumberOfPlayer)
+	if game.Settings.Mode == SCORE {
+		copy(standing, game.Scores)
+	} else {
+		for position := range game.BoardPositioning {
+			ownedBy := game.TileOwner(uint8(position))
+			if ownedBy > 0 && int(ownedBy) <= len(standing) {
+				standing[ownedBy-1]++
+			}
+		}
+	}
+
+	ranking := make([]uint8, game.NumberOfPlayer)
+	for i := range ranking {
+		ranking[i] = uint8(i)
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return standing[ranking[i]] > standing[ranking[j]]
+	})
+
+	return ranking
+}
+
+// Snapshot copies the undoable part of the game before it is mutated by a move.
+func (game Game) Snapshot() GameSnapshot {
+	return GameSnapshot{
+		CurrentPlayerOrder: game.CurrentPlayerOrder,
+		State:              game.State,
+		LetterBank:         append(LetterBank{}, game.LetterBank...),
+		BoardBase:          append([]uint8{}, game.BoardBase...),
+		BoardPositioning:   append([]uint8{}, game.BoardPositioning...),
+		BoardModifiers:     append([]uint8{}, game.BoardModifiers...),
+		Scores:             append(Scores{}, game.Scores...),
+	}
+}
+
+// Restore brings the game back to the given snapshot and forgets it.
+func (game *Game) Restore(snapshot GameSnapshot) {
+	game.CurrentPlayerOrder = snapshot.CurrentPlayerOrder
+	game.State = snapshot.State
+	game.LetterBank = snapshot.LetterBank
+	game.BoardBase = snapshot.BoardBase
+	game.BoardPositioning = snapshot.BoardPositioning
+	game.BoardModifiers = snapshot.BoardModifiers
+	game.Scores = snapshot.Scores
+	game.PreviousState = GameSnapshot{}
+}
+
+// Add credits points to the player on the given order, growing the scores when needed.
+func (scores *Scores) Add(playerOrder uint8, points uint32) {
+	for len(*scores) <= int(playerOrder) {
+		*scores = append(*scores, 0)
+	}
+	(*scores)[playerOrder] += points
+}
+
+func (scores Scores) Value() (driver.Value, error) {
+	return json.Marshal(scores)
+}
+
+func (scores *Scores) Scan(src interface{}) error {
+	return scanJson(src, scores)
+}
+
+func (settings GameSettings) Value() (driver.Value, error) {
+	return json.Marshal(settings)
+}
+
+func (settings *GameSettings) Scan(src interface{}) error {
+	return scanJson(src, settings)
+}
+
+func (snapshot GameSnapshot) Value() (driver.Value, error) {
+	if snapshot.PlayerId == 0 {
+		return nil, nil
+	}
+	return json.Marshal(snapshot)
+}
+
+func (snapshot *GameSnapshot) Scan(src interface{}) error {
+	*snapshot = GameSnapshot{}
+	return scanJson(src, snapshot)
+}
+
+func (moves PendingMoves) Value() (driver.Value, error) {
+	if len(moves) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(moves)
+}
+
+func (moves *PendingMoves) Scan(src interface{}) error {
+	*moves = nil
+	return scanJson(src, moves)
+}
+
+func (words Words) Value() (driver.Value, error) {
+	return json.Marshal(words)
+}
+
+func (words *Words) Scan(src interface{}) error {
+	return scanJson(src, words)
+}
+
+func scanJson(src interface{}, dest interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if len(value) == 0 {
+			return nil
+		}
+		return json.Unmarshal(value, dest)
+	case string:
+		if len(value) == 0 {
+			return nil
+		}
+		return json.Unmarshal([]byte(value), dest)
+	default:
+		return ErrorUnscannable
+	}
+}
@@ -0,0 +1,148 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGame_Ranking(t *testing.T) {
+	t.Run("Area", func(t *testing.T) {
+		game := data.Game{
+			NumberOfPlayer:   3,
+			BoardPositioning: []uint8{0, 2, 2, 6, 3, 7, 1},
+			Scores:           data.Scores{10, 0, 0},
+		}
+		assert.Equal(t, []uint8{1, 2, 0}, game.Ranking())
+	})
+	t.Run("Score", func(t *testing.T) {
+		game := data.Game{
+			NumberOfPlayer:   3,
+			BoardPositioning: []uint8{0, 2, 2, 6, 3, 1, 0},
+			Settings:         data.GameSettings{Mode: data.SCORE},
+			Scores:           data.Scores{10, 4, 12},
+		}
+		assert.Equal(t, []uint8{2, 0, 1}, game.Ranking())
+	})
+	t.Run("Tie", func(t *testing.T) {
+		game := data.Game{
+			NumberOfPlayer: 2,
+			Settings:       data.GameSettings{Mode: data.SCORE},
+		}
+		assert.Equal(t, []uint8{0, 1}, game.Ranking())
+	})
+}
+
+func TestScores_Add(t *testing.T) {
+	scores := data.Scores{}
+	scores.Add(1, 5)
+	scores.Add(1, 2)
+	assert.Equal(t, data.Scores{0, 7}, scores)
+}
+
+func TestScores_Scan(t *testing.T) {
+	t.Run("Null", func(t *testing.T) {
+		var scores data.Scores
+		if assert.NoError(t, scores.Scan(nil)) {
+			assert.Nil(t, scores)
+		}
+	})
+	t.Run("Json", func(t *testing.T) {
+		var scores data.Scores
+		if assert.NoError(t, scores.Scan([]byte(`[1,2]`))) {
+			assert.Equal(t, data.Scores{1, 2}, scores)
+		}
+	})
+	t.Run("Unscannable", func(t *testing.T) {
+		var scores data.Scores
+		assert.EqualError(t, scores.Scan(3), data.ErrorUnscannable.Error())
+	})
+}
+
+func TestPendingMoves_Value(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		value, err := data.PendingMoves{}.Value()
+		if assert.NoError(t, err) {
+			assert.Nil(t, value, "null, so the game is not pending")
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		moves := data.PendingMoves{{PlayerId: 1, Word: "makan", Pending: true}}
+		value, err := moves.Value()
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		var scanned data.PendingMoves
+		if assert.NoError(t, scanned.Scan(value)) {
+			assert.Equal(t, moves, scanned)
+		}
+	})
+}
+
+func TestHouseRules_Verdict(t *testing.T) {
+	rules := data.HouseRules{
+		AllowedWords: []string{"anjay", "gokil"},
+		BannedWords:  []string{"gokil"},
+	}
+	for word, expected := range map[string][2]bool{
+		"anjay": {true, true},
+		"gokil": {false, true},
+		"makan": {false, false},
+	} {
+		valid, decided := rules.Verdict(word)
+		assert.Equal(t, expected, [2]bool{valid, decided}, word)
+	}
+}
+
+func TestGame_Snapshot(t *testing.T) {
+	game := data.Game{
+		CurrentPlayerOrder: 1,
+		State:              data.ONGOING,
+		BoardBase:          []uint8{1, 2},
+		BoardPositioning:   []uint8{0, 1},
+		Scores:             data.Scores{3, 4},
+	}
+	snapshot := game.Snapshot()
+
+	game.BoardBase[0] = 9
+	game.BoardPositioning[0] = 2
+	game.Scores.Add(1, 1)
+	game.CurrentPlayerOrder = 0
+	game.State = data.END
+
+	game.Restore(snapshot)
+	assert.Equal(t, uint8(1), game.CurrentPlayerOrder)
+	assert.Equal(t, data.ONGOING, game.State)
+	assert.Equal(t, []uint8{1, 2}, game.BoardBase)
+	assert.Equal(t, []uint8{0, 1}, game.BoardPositioning)
+	assert.Equal(t, data.Scores{3, 4}, game.Scores)
+}
+
+func TestGame_Tile(t *testing.T) {
+	game := data.Game{
+		NumberOfPlayer:   2,
+		BoardPositioning: []uint8{0, 1, 4, 2, 2},
+		BoardModifiers:   []uint8{uint8(data.LOCKED), 0, 0, uint8(data.LOCKED), 0},
+	}
+	t.Run("Vacant", func(t *testing.T) {
+		assert.Equal(t, uint8(0), game.TileOwner(0))
+		assert.Equal(t, uint8(0), game.TileStrength(0))
+		assert.False(t, game.TileDefended(0))
+	})
+	t.Run("Weak", func(t *testing.T) {
+		assert.Equal(t, uint8(1), game.TileOwner(1))
+		assert.Equal(t, uint8(1), game.TileStrength(1))
+		assert.False(t, game.TileDefended(1))
+	})
+	t.Run("Strong", func(t *testing.T) {
+		assert.Equal(t, uint8(1), game.TileOwner(2))
+		assert.Equal(t, uint8(2), game.TileStrength(2))
+		assert.True(t, game.TileDefended(2))
+	})
+	t.Run("Locked", func(t *testing.T) {
+		assert.Equal(t, uint8(2), game.TileOwner(3))
+		assert.Equal(t, uint8(1), game.TileStrength(3))
+		assert.True(t, game.TileDefended(3))
+	})
+}
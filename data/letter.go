@@ -1,10 +1,169 @@
 package data
 
-func Letters(language string) (string, error) {
+import (
+	"sort"
+	"strings"
+)
+
+// Letters returns the letters of the language, the blank first. A letter may be more than one character, like ng or ij.
+func Letters(language string) ([]string, error) {
 	tile := tiles[language]
 	if len(tile.Letters) == 0 {
-		return "", ErrorNoLanguageFound
+		return nil, ErrorNoLanguageFound
 	}
 
 	return tile.Letters, nil
 }
+
+// LetterPoints returns the point of each letter, indexed the same way as Letters.
+func LetterPoints(language string) ([]int, error) {
+	tile := tiles[language]
+	if len(tile.Letters) == 0 {
+		return nil, ErrorNoLanguageFound
+	}
+
+	points := make([]int, len(tile.Letters))
+	for i := 1; i < len(points); i++ {
+		points[i] = 1
+		if len(tile.Points) > 0 {
+			points[i] = tile.Points[i-1]
+		}
+	}
+
+	return points, nil
+}
+
+// DictionaryLanguage tells the dictionary validating words made of the language tiles.
+func DictionaryLanguage(language string) (string, error) {
+	tile := tiles[language]
+	if len(tile.Letters) == 0 {
+		return "", ErrorNoLanguageFound
+	}
+
+	return tile.Dictionary, nil
+}
+
+// DictionaryLanguages tells the dictionary languages of every registered tiles, sorted.
+func DictionaryLanguages() []string {
+	seen := make(map[string]bool)
+	var dictionaryLanguages []string
+	for _, tile := range tiles {
+		if !seen[tile.Dictionary] {
+			seen[tile.Dictionary] = true
+			dictionaryLanguages = append(dictionaryLanguages, tile.Dictionary)
+		}
+	}
+	sort.Strings(dictionaryLanguages)
+	return dictionaryLanguages
+}
+
+// BoardTiles are the tiles dealt on the 5 by 5 board of a new game, a language has at least as many.
+const BoardTiles = 25
+
+// RegisterTiles adds the tiles of a language, like one read from a language pack.
+// It is not safe to call once games are served.
+func RegisterTiles(language string, languageTiles Tiles) error {
+	if _, exist := tiles[language]; exist {
+		return ErrorLanguageExist
+	}
+	numberOfLetter := len(languageTiles.Letters) - 1
+	if language == "" || numberOfLetter < 1 || numberOfLetter > 255 || languageTiles.Letters[0] != "" ||
+		len(languageTiles.Distribution) != numberOfLetter ||
+		(len(languageTiles.Points) > 0 && len(languageTiles.Points) != numberOfLetter) ||
+		languageTiles.Dictionary == "" {
+		return ErrorTilesInvalid
+	}
+	total := 0
+	for _, num := range languageTiles.Distribution {
+		if num < 0 {
+			return ErrorTilesInvalid
+		}
+		total += num
+	}
+	if total < BoardTiles {
+		return ErrorTilesInvalid
+	}
+
+	letters := make([]string, len(languageTiles.Letters))
+	seen := make(map[string]bool)
+	for i, letter := range languageTiles.Letters[1:] {
+		letter = FoldWord(letter)
+		if letter == "" || seen[letter] {
+			return ErrorTilesInvalid
+		}
+		seen[letter] = true
+		letters[i+1] = letter
+	}
+	languageTiles.Letters = letters
+
+	tiles[language] = languageTiles
+	return nil
+}
+
+// FoldWord lowercases the word and composes the Latin letters followed by combining marks of compositions,
+// e.g. n followed by a combining tilde becomes ñ, so a tile word reaches the dictionary however it was typed.
+// It is NFC on Latin script, the combining sequences of the other scripts are kept as they are.
+func FoldWord(word string) string {
+	runes := []rune(strings.ToLower(word))
+	composed := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		if n := len(composed); n > 0 {
+			if precomposed, ok := compositions[[2]rune{composed[n-1], r}]; ok {
+				composed[n-1] = precomposed
+				continue
+			}
+		}
+		composed = append(composed, r)
+	}
+	return string(composed)
+}
+
+// splitLetters makes single character letters, the blank first.
+func splitLetters(alphabet string) []string {
+	letters := []string{""}
+	for _, letter := range alphabet {
+		letters = append(letters, string(letter))
+	}
+	return letters
+}
+
+// compositions of the Latin letters with the combining diacritical marks, by base letter and combining mark,
+// as of the canonical compositions of Unicode 14. A letter composed already composes with a further mark,
+// in either order when one mark is above and the other below, e.g. ạ with a circumflex or â with a dot below makes ậ.
+var compositions = func() map[[2]rune]rune {
+	marks := map[rune]struct{ bases, precomposed string }{
+		'\u0300': {"aeinouwyâêôüăēōơư", "àèìǹòùẁỳầềồǜằḕṑờừ"},                                 // grave
+		'\u0301': {"acegiklmnoprsuwyzâåæçêïôõøüăēōũơư", "áćéǵíḱĺḿńóṕŕśúẃýźấǻǽḉếḯốṍǿǘắḗṓṹớứ"}, // acute
+		'\u0302': {"aceghijosuwyzạẹọ", "âĉêĝĥîĵôŝûŵŷẑậệộ"},                                   // circumflex
+		'\u0303': {"aeinouvyâêôăơư", "ãẽĩñõũṽỹẫễỗẵỡữ"},                                       // tilde
+		'\u0304': {"aegiouyäæõöüǫȧȯḷṛ", "āēḡīōūȳǟǣȭȫǖǭǡȱḹṝ"},                                 // macron
+		'\u0306': {"aegiouȩạ", "ăĕğĭŏŭḝặ"},                                                   // breve
+		'\u0307': {"abcdefghmnoprstwxyzśšſṣ", "ȧḃċḋėḟġḣṁṅȯṗṙṡṫẇẋẏżṥṧẛṩ"},                     // dot above
+		'\u0308': {"aehiotuwxyõū", "äëḧïöẗüẅẍÿṏṻ"},                                           // diaeresis
+		'\u0309': {"aeiouyâêôăơư", "ảẻỉỏủỷẩểổẳởử"},                                           // hook above
+		'\u030a': {"auwy", "åůẘẙ"},                                                           // ring above
+		'\u030b': {"ou", "őű"},                                                               // double acute
+		'\u030c': {"acdeghijklnorstuzü", "ǎčďěǧȟǐǰǩľňǒřšťǔžǚ"},                               // caron
+		'\u030f': {"aeioru", "ȁȅȉȍȑȕ"},                                                       // double grave
+		'\u0311': {"aeioru", "ȃȇȋȏȓȗ"},                                                       // inverted breve
+		'\u031b': {"ouòóõùúũọỏụủ", "ơườớỡừứữợởựử"},                                           // horn
+		'\u0323': {"abdehiklmnorstuvwyzâêôăơưṡ", "ạḅḍẹḥịḳḷṃṇọṛṣṭụṿẉỵẓậệộặợựṩ"},               // dot below
+		'\u0324': {"u", "ṳ"},                                                                 // diaeresis below
+		'\u0325': {"a", "ḁ"},                                                                 // ring below
+		'\u0326': {"st", "șț"},                                                               // comma below
+		'\u0327': {"cdeghklnrstćĕ", "çḑȩģḩķļņŗşţḉḝ"},                                         // cedilla
+		'\u0328': {"aeiouō", "ąęįǫųǭ"},                                                       // ogonek
+		'\u032d': {"delntu", "ḓḙḽṋṱṷ"},                                                       // circumflex below
+		'\u032e': {"h", "ḫ"},                                                                 // breve below
+		'\u0330': {"eiu", "ḛḭṵ"},                                                             // tilde below
+		'\u0331': {"bdhklnrtz", "ḇḏẖḵḻṉṟṯẕ"},                                                 // macron below
+	}
+	compositions := make(map[[2]rune]rune)
+	for mark, letters := range marks {
+		precomposed := []rune(letters.precomposed)
+		for i, base := range []rune(letters.bases) {
+			compositions[[2]rune{base, mark}] = precomposed[i]
+		}
+	}
+	return compositions
+}()
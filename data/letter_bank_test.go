@@ -12,6 +12,12 @@ func TestNewLetterBank(t *testing.T) {
 		_, err := data.NewLetterBank("--")
 		assert.EqualError(t, err, data.ErrorNoLanguageFound.Error())
 	})
+	t.Run("English", func(t *testing.T) {
+		letterBank, err := data.NewLetterBank("en")
+		if assert.NoError(t, err) {
+			assert.Len(t, letterBank, 98)
+		}
+	})
 	t.Run("Success", func(t *testing.T) {
 		letterBank, err := data.NewLetterBank("id")
 		if assert.NoError(t, err) {
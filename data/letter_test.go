@@ -15,7 +15,128 @@ func TestLetters(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		letters, err := data.Letters("id")
 		if assert.NoError(t, err) {
-			assert.Equal(t, " abcdefghijklmnopqrstuvwxyz", letters)
+			assert.Len(t, letters, 27)
+			assert.Equal(t, "", letters[0], "blank")
+			assert.Equal(t, "a", letters[1])
+			assert.Equal(t, "z", letters[26])
 		}
 	})
 }
+
+func TestLetterPoints(t *testing.T) {
+	t.Run("ErrorNoLanguageFound", func(t *testing.T) {
+		_, err := data.LetterPoints("--")
+		assert.EqualError(t, err, data.ErrorNoLanguageFound.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		points, err := data.LetterPoints("id")
+		if assert.NoError(t, err) {
+			assert.Len(t, points, 27)
+			assert.Equal(t, 0, points[0], "blank")
+			assert.Equal(t, 1, points[1], "a")
+			assert.Equal(t, 8, points[26], "z")
+		}
+	})
+	t.Run("English", func(t *testing.T) {
+		points, err := data.LetterPoints("en")
+		if assert.NoError(t, err) {
+			assert.Equal(t, 10, points[17], "q")
+			assert.Equal(t, 10, points[26], "z")
+		}
+	})
+}
+
+func TestDictionaryLanguage(t *testing.T) {
+	t.Run("ErrorNoLanguageFound", func(t *testing.T) {
+		_, err := data.DictionaryLanguage("--")
+		assert.EqualError(t, err, data.ErrorNoLanguageFound.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		for language, dictionary := range map[string]string{"id": "id-id", "en": "en-us"} {
+			result, err := data.DictionaryLanguage(language)
+			if assert.NoError(t, err) {
+				assert.Equal(t, dictionary, result)
+			}
+		}
+	})
+}
+
+func TestDictionaryLanguages(t *testing.T) {
+	assert.Subset(t, data.DictionaryLanguages(), []string{"en-us", "id-id"})
+}
+
+func TestGameSettings_TileLanguage(t *testing.T) {
+	assert.Equal(t, "id", data.GameSettings{}.TileLanguage(), "made before languages were selectable")
+	assert.Equal(t, "en", data.GameSettings{Language: "en"}.TileLanguage())
+}
+
+func TestRegisterTiles(t *testing.T) {
+	tiles := data.Tiles{
+		Distribution: []int{24, 1},
+		Points:       []int{1, 2},
+		Letters:      []string{"", "a", "IJ"},
+		Dictionary:   "xx-xx",
+	}
+	t.Run("ErrorTilesInvalid", func(t *testing.T) {
+		for name, invalid := range map[string]data.Tiles{
+			"NoBlank":           {Distribution: []int{2, 1}, Letters: []string{"a", "b"}, Dictionary: "xx-xx"},
+			"ShortDistribution": {Distribution: []int{2}, Letters: []string{"", "a", "b"}, Dictionary: "xx-xx"},
+			"ShortPoints":       {Distribution: []int{2, 1}, Points: []int{1}, Letters: []string{"", "a", "b"}, Dictionary: "xx-xx"},
+			"NoDictionary":      {Distribution: []int{2, 1}, Letters: []string{"", "a", "b"}},
+			"EmptyLetter":       {Distribution: []int{2, 1}, Letters: []string{"", "a", ""}, Dictionary: "xx-xx"},
+			"DuplicateLetter":   {Distribution: []int{2, 1}, Letters: []string{"", "a", "A"}, Dictionary: "xx-xx"},
+			"FewerThanTheBoard": {Distribution: []int{20, 4}, Letters: []string{"", "a", "b"}, Dictionary: "xx-xx"},
+			"NegativeCount":     {Distribution: []int{30, -1}, Letters: []string{"", "a", "b"}, Dictionary: "xx-xx"},
+		} {
+			assert.EqualError(t, data.RegisterTiles("xx", invalid), data.ErrorTilesInvalid.Error(), name)
+		}
+	})
+	t.Run("ErrorLanguageExist", func(t *testing.T) {
+		assert.EqualError(t, data.RegisterTiles("id", tiles), data.ErrorLanguageExist.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		if assert.NoError(t, data.RegisterTiles("xx", tiles)) {
+			letterBank, err := data.NewLetterBank("xx")
+			if assert.NoError(t, err) {
+				assert.Len(t, letterBank, data.BoardTiles)
+				assert.Equal(t, uint8(2), letterBank[data.BoardTiles-1])
+			}
+			letters, err := data.Letters("xx")
+			if assert.NoError(t, err) {
+				assert.Equal(t, []string{"", "a", "ij"}, letters, "normalized")
+			}
+			dictionary, err := data.DictionaryLanguage("xx")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "xx-xx", dictionary)
+			}
+		}
+	})
+}
+
+func TestFoldWord(t *testing.T) {
+	testCases := []struct {
+		word   string
+		folded string
+	}{
+		{"Kata", "kata"},
+		{"ma\u00f1ana", "mañana"},
+		{"man\u0303ana", "mañana"},
+		{"CAFE\u0301", "café"},
+		{"IJsje", "ijsje"},
+		{"a\u0328", "ą"},                 // ogonek
+		{"g\u0306", "ğ"},                 // breve
+		{"S\u0326", "ș"},                 // comma below
+		{"O\u030b", "ő"},                 // double acute
+		{"e\u0323", "ẹ"},                 // dot below
+		{"o\u0304", "ō"},                 // macron
+		{"a\u0323\u0302", "ậ"},           // below then above
+		{"a\u0302\u0323", "ậ"},           // above then below
+		{"\u1ea1\u0302", "ậ"},            // precomposed then above
+		{"\u01b0\u0303", "ữ"},            // horned u with a tilde
+		{"x\u0303", "x\u0303"},           // no precomposed letter
+		{"\u0438\u0306", "\u0438\u0306"}, // not Latin
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.folded, data.FoldWord(tc.word), tc.word)
+	}
+}
@@ -46,8 +46,8 @@ func (t *Transactional) FinalizeTransaction(tx *sql.Tx, err error) error {
 func (t *Transactional) InsertGame(ctx context.Context, tx *sql.Tx, game data.Game) (data.Game, error) {
 	result, err := tx.ExecContext(
 		ctx,
-		"INSERT INTO games (current_player_order, number_of_player, board_base, board_positioning, letter_bank, state) VALUES (?, ?, ?, ?, ?, ?)",
-		game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.LetterBank, game.State,
+		"INSERT INTO games (current_player_order, number_of_player, board_base, board_positioning, board_modifiers, letter_bank, state, settings, scores) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.BoardModifiers, game.LetterBank, game.State, game.Settings, game.Scores,
 	)
 	if err != nil {
 		log.Println(err)
@@ -96,7 +96,8 @@ func (t *Transactional) GetPlayersByGameId(ctx context.Context, gameId data.Game
 			INNER JOIN (
 				SELECT player_id FROM games_players WHERE game_id = ?
 			) as game_players 
-			ON game_players.player_id = players.id`,
+			ON game_players.player_id = players.id
+		ORDER BY players.id`,
 		gameId,
 	)
 	if err != nil {
@@ -117,17 +118,18 @@ func (t *Transactional) GetPlayersByGameId(ctx context.Context, gameId data.Game
 }
 
 func (t *Transactional) GetGameById(ctx context.Context, tx *sql.Tx, gameId data.GameId) (game data.Game, err error) {
-	query := "SELECT current_player_order, number_of_player, board_base, board_positioning, letter_bank, state FROM games WHERE id = ?"
+	query := "SELECT current_player_order, number_of_player, board_base, board_positioning, board_modifiers, letter_bank, state, settings, scores, previous_state, pending_moves FROM games WHERE id = ?"
 	args := []interface{}{gameId}
 
 	var row *sql.Row
 	if tx != nil {
-		row = tx.QueryRowContext(ctx, query, args...)
+		// the game is read to be updated, the concurrent writers wait for the transaction
+		row = tx.QueryRowContext(ctx, query+" FOR UPDATE", args...)
 	} else {
 		row = t.db.QueryRowContext(ctx, query, args...)
 	}
 
-	err = row.Scan(&game.CurrentPlayerOrder, &game.NumberOfPlayer, &game.BoardBase, &game.BoardPositioning, &game.LetterBank, &game.State)
+	err = row.Scan(&game.CurrentPlayerOrder, &game.NumberOfPlayer, &game.BoardBase, &game.BoardPositioning, &game.BoardModifiers, &game.LetterBank, &game.State, &game.Settings, &game.Scores, &game.PreviousState, &game.PendingMoves)
 	if err != nil {
 		return
 	}
@@ -137,7 +139,7 @@ func (t *Transactional) GetGameById(ctx context.Context, tx *sql.Tx, gameId data
 }
 
 func (t *Transactional) GetGamePlayersByGameId(ctx context.Context, tx *sql.Tx, gameId data.GameId) (gamePlayers []data.GamePlayer, err error) {
-	rows, err := tx.QueryContext(ctx, "SELECT player_id FROM games_players WHERE game_id = ?", gameId)
+	rows, err := tx.QueryContext(ctx, "SELECT player_id FROM games_players WHERE game_id = ? ORDER BY player_id", gameId)
 	if err != nil {
 		return []data.GamePlayer{}, err
 	}
@@ -159,7 +161,7 @@ func (t *Transactional) GetGamePlayersByGameId(ctx context.Context, tx *sql.Tx,
 
 func (t *Transactional) GetGamesByPlayerId(ctx context.Context, playerId data.PlayerId) (games []data.Game, err error) {
 	rows, err := t.db.QueryContext(ctx,
-		`SELECT id, current_player_order, number_of_player, board_base, board_positioning, state
+		`SELECT id, current_player_order, number_of_player, board_base, board_positioning, board_modifiers, state, settings, scores
 		FROM games
 			INNER JOIN (
 				SELECT game_id FROM games_players WHERE player_id = ?
@@ -174,7 +176,7 @@ func (t *Transactional) GetGamesByPlayerId(ctx context.Context, playerId data.Pl
 
 	for rows.Next() {
 		var game data.Game
-		err = rows.Scan(&game.Id, &game.CurrentPlayerOrder, &game.NumberOfPlayer, &game.BoardBase, &game.BoardPositioning, &game.State)
+		err = rows.Scan(&game.Id, &game.CurrentPlayerOrder, &game.NumberOfPlayer, &game.BoardBase, &game.BoardPositioning, &game.BoardModifiers, &game.State, &game.Settings, &game.Scores)
 		if err != nil {
 			return
 		}
@@ -220,14 +222,47 @@ func (t *Transactional) GetPlayedWordsByGameId(ctx context.Context, gameId data.
 	return
 }
 
+func (t *Transactional) DeletePlayedWord(ctx context.Context, tx *sql.Tx, gameId data.GameId, word string) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM played_words WHERE game_id = ? AND word = ?",
+		gameId, word,
+	)
+	return err
+}
+
 func (t *Transactional) UpdateGame(ctx context.Context, tx *sql.Tx, game data.Game) error {
 	_, err := tx.ExecContext(ctx,
-		"UPDATE games SET board_positioning = ?, board_base = ?, current_player_order = ?, letter_bank = ?, state  = ? WHERE id = ?",
-		game.BoardPositioning, game.BoardBase, game.CurrentPlayerOrder, game.LetterBank, game.State, game.Id,
+		"UPDATE games SET board_positioning = ?, board_base = ?, board_modifiers = ?, current_player_order = ?, letter_bank = ?, state  = ?, scores = ?, previous_state = ?, pending_moves = ? WHERE id = ?",
+		game.BoardPositioning, game.BoardBase, game.BoardModifiers, game.CurrentPlayerOrder, game.LetterBank, game.State, game.Scores, game.PreviousState, game.PendingMoves, game.Id,
 	)
 	return err
 }
 
+// GetPendingGameIds tells the games having moves whose word is still to be verified.
+func (t *Transactional) GetPendingGameIds(ctx context.Context) (gameIds []data.GameId, err error) {
+	rows, err := t.db.QueryContext(ctx, "SELECT id FROM games WHERE pending_moves IS NOT NULL")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var gameId data.GameId
+		err = rows.Scan(&gameId)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		gameIds = append(gameIds, gameId)
+	}
+
+	return
+}
+
 func (t *Transactional) UpsertPlayer(ctx context.Context, tx *sql.Tx, player data.Player) (err error) {
 	_, err = tx.ExecContext(ctx,
 		`INSERT IGNORE INTO players (device_fingerprint, username) VALUES (?, ?) ON DUPLICATE KEY UPDATE username = ?`, player.DeviceFingerprint, player.Username, player.Username,
@@ -251,3 +286,240 @@ func (t *Transactional) GetPlayerByDeviceFingerprint(ctx context.Context, tx *sq
 
 	return
 }
+
+func (t *Transactional) InsertDispute(ctx context.Context, dispute data.Dispute) (data.Dispute, error) {
+	result, err := t.db.ExecContext(ctx,
+		"INSERT INTO disputes (game_id, player_id, language, word, valid, source, state, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		dispute.GameId, dispute.PlayerId, dispute.Language, dispute.Word, dispute.Valid, dispute.Source, dispute.State, dispute.CreatedAt,
+	)
+	if err != nil {
+		log.Println(err)
+		return data.Dispute{}, err
+	}
+
+	disputeIdInt64, _ := result.LastInsertId()
+	dispute.Id = data.DisputeId(disputeIdInt64)
+
+	return dispute, nil
+}
+
+const disputeColumns = "id, game_id, player_id, language, word, valid, source, state, moderator, created_at, decided_at"
+
+func (t *Transactional) GetDisputeById(ctx context.Context, tx *sql.Tx, disputeId data.DisputeId) (dispute data.Dispute, err error) {
+	query := "SELECT " + disputeColumns + " FROM disputes WHERE id = ? FOR UPDATE"
+	row := tx.QueryRowContext(ctx, query, disputeId)
+
+	err = row.Scan(&dispute.Id, &dispute.GameId, &dispute.PlayerId, &dispute.Language, &dispute.Word, &dispute.Valid,
+		&dispute.Source, &dispute.State, &dispute.Moderator, &dispute.CreatedAt, &dispute.DecidedAt)
+	return
+}
+
+func (t *Transactional) GetDisputesByState(ctx context.Context, state data.DisputeState) (disputes []data.Dispute, err error) {
+	rows, err := t.db.QueryContext(ctx,
+		"SELECT "+disputeColumns+" FROM disputes WHERE state = ? ORDER BY id",
+		state,
+	)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var dispute data.Dispute
+		err = rows.Scan(&dispute.Id, &dispute.GameId, &dispute.PlayerId, &dispute.Language, &dispute.Word, &dispute.Valid,
+			&dispute.Source, &dispute.State, &dispute.Moderator, &dispute.CreatedAt, &dispute.DecidedAt)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		disputes = append(disputes, dispute)
+	}
+
+	return
+}
+
+func (t *Transactional) GetPendingDispute(ctx context.Context, language, word string) (dispute data.Dispute, exist bool, err error) {
+	row := t.db.QueryRowContext(ctx,
+		"SELECT "+disputeColumns+" FROM disputes WHERE state = ? AND language = ? AND word = ? ORDER BY id LIMIT 1",
+		data.PENDING, language, word,
+	)
+
+	err = row.Scan(&dispute.Id, &dispute.GameId, &dispute.PlayerId, &dispute.Language, &dispute.Word, &dispute.Valid,
+		&dispute.Source, &dispute.State, &dispute.Moderator, &dispute.CreatedAt, &dispute.DecidedAt)
+	if err == sql.ErrNoRows {
+		return dispute, false, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	return dispute, true, nil
+}
+
+func (t *Transactional) UpdateDispute(ctx context.Context, tx *sql.Tx, dispute data.Dispute) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE disputes SET state = ?, moderator = ?, decided_at = ? WHERE id = ?",
+		dispute.State, dispute.Moderator, dispute.DecidedAt, dispute.Id,
+	)
+	return err
+}
+
+func (t *Transactional) UpsertWordOverride(ctx context.Context, tx *sql.Tx, override data.WordOverride) error {
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO word_overrides (language, word, valid, dispute_id) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE valid = ?, dispute_id = ?",
+		override.Language, override.Word, override.Valid, override.DisputeId, override.Valid, override.DisputeId,
+	)
+	return err
+}
+
+func (t *Transactional) GetWordOverride(ctx context.Context, language, word string) (override data.WordOverride, exist bool, err error) {
+	row := t.db.QueryRowContext(ctx,
+		"SELECT language, word, valid, dispute_id FROM word_overrides WHERE language = ? AND word = ?",
+		language, word,
+	)
+
+	err = row.Scan(&override.Language, &override.Word, &override.Valid, &override.DisputeId)
+	if err == sql.ErrNoRows {
+		return override, false, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	return override, true, nil
+}
+
+func (t *Transactional) InsertWordList(ctx context.Context, wordList data.WordList) (data.WordList, error) {
+	result, err := t.db.ExecContext(ctx,
+		"INSERT INTO word_lists (player_id, name, words) VALUES (?, ?, ?)",
+		wordList.PlayerId, wordList.Name, wordList.Words,
+	)
+	if err != nil {
+		log.Println(err)
+		return data.WordList{}, err
+	}
+
+	wordListIdInt64, _ := result.LastInsertId()
+	wordList.Id = data.WordListId(wordListIdInt64)
+
+	return wordList, nil
+}
+
+func (t *Transactional) GetWordListById(ctx context.Context, wordListId data.WordListId) (wordList data.WordList, err error) {
+	row := t.db.QueryRowContext(ctx,
+		"SELECT id, player_id, name, words FROM word_lists WHERE id = ?",
+		wordListId,
+	)
+
+	err = row.Scan(&wordList.Id, &wordList.PlayerId, &wordList.Name, &wordList.Words)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	return
+}
+
+func (t *Transactional) GetWordListsByPlayerId(ctx context.Context, playerId data.PlayerId) (wordLists []data.WordList, err error) {
+	rows, err := t.db.QueryContext(ctx,
+		"SELECT id, player_id, name, words FROM word_lists WHERE player_id = ? ORDER BY id",
+		playerId,
+	)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var wordList data.WordList
+		err = rows.Scan(&wordList.Id, &wordList.PlayerId, &wordList.Name, &wordList.Words)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		wordLists = append(wordLists, wordList)
+	}
+
+	return
+}
+
+func (t *Transactional) GetLemma(ctx context.Context, language, word string) (lemma data.Lemma, exist bool, err error) {
+	row := t.db.QueryRowContext(ctx,
+		"SELECT language, word, valid, source, fetched_at, overridden FROM lemmas WHERE language = ? AND word = ?",
+		language, word,
+	)
+
+	err = row.Scan(&lemma.Language, &lemma.Word, &lemma.Valid, &lemma.Source, &lemma.FetchedAt, &lemma.Overridden)
+	if err == sql.ErrNoRows {
+		return lemma, false, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	return lemma, true, nil
+}
+
+// UpsertLemma keeps an overridden lemma unless the new one is overridden too.
+func (t *Transactional) UpsertLemma(ctx context.Context, lemma data.Lemma) error {
+	_, err := t.db.ExecContext(ctx,
+		"INSERT INTO lemmas (language, word, valid, source, fetched_at, overridden) VALUES (?, ?, ?, ?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE "+
+			"valid = IF(overridden AND NOT VALUES(overridden), valid, VALUES(valid)), "+
+			"source = IF(overridden AND NOT VALUES(overridden), source, VALUES(source)), "+
+			"fetched_at = IF(overridden AND NOT VALUES(overridden), fetched_at, VALUES(fetched_at)), "+
+			"overridden = overridden OR VALUES(overridden)",
+		lemma.Language, lemma.Word, lemma.Valid, lemma.Source, lemma.FetchedAt, lemma.Overridden,
+	)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func (t *Transactional) DeleteLemma(ctx context.Context, language, word string) error {
+	_, err := t.db.ExecContext(ctx,
+		"DELETE FROM lemmas WHERE language = ? AND word = ?",
+		language, word,
+	)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func (t *Transactional) GetValidLemmaWords(ctx context.Context, language string) ([]string, error) {
+	return t.getWords(ctx, "SELECT word FROM lemmas WHERE language = ? AND valid", language)
+}
+
+func (t *Transactional) GetValidOverrideWords(ctx context.Context, language string) ([]string, error) {
+	return t.getWords(ctx, "SELECT word FROM word_overrides WHERE language = ? AND valid", language)
+}
+
+func (t *Transactional) getWords(ctx context.Context, query string, args ...interface{}) (words []string, err error) {
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var word string
+		err = rows.Scan(&word)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		words = append(words, word)
+	}
+
+	return
+}
@@ -32,14 +32,19 @@ var (
 	currentOrder     = uint8(1)
 	boardBase        = []uint8{22, 14, 17, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
 	boardPositioning = []uint8{2, 2, 2, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	boardModifiers   = []uint8{0, 0, 1, 0, 0, 2, 0, 0, 0, 0, 0, 3, 0, 0, 0, 4, 0, 0, 0, 0}
 	letterBank       = []uint8{22, 14, 17, 3, 4, 5, 6, 7}
 	wordString       = "word"
+	settings         = data.GameSettings{Mode: data.SCORE}
+	scores           = data.Scores{3, 5}
+	previousState    = data.GameSnapshot{PlayerId: playerId, Word: wordString, Scores: data.Scores{3, 0}}
+	pendingMoves     = data.PendingMoves{{PlayerId: playerId, Word: wordString, Scores: data.Scores{3, 0}, Pending: true}}
 	timestamp        = time.Now()
 	fingerprint      = `69df370f86b026724a73c68599a60a5ce1d19a5c6df8b33e0fc24e8f6310c668372aeee8ed4929ae8b4f646da799230dbc205af61f36794a9a89b1cc093fb648`
 )
 
 var (
-	gameColumn       = []string{"current_player_order", "number_of_player", "board_base", "board_positioning", "letter_bank", "state"}
+	gameColumn       = []string{"current_player_order", "number_of_player", "board_base", "board_positioning", "board_modifiers", "letter_bank", "state", "settings", "scores", "previous_state", "pending_moves"}
 	gamePlayerColumn = []string{"game_id", "player_id"}
 	playerColumn     = []string{"id", "username"}
 )
@@ -146,7 +151,10 @@ func TestTransactional_InsertGame(t *testing.T) {
 		NumberOfPlayer:     2,
 		BoardBase:          boardBase,
 		BoardPositioning:   boardPositioning,
+		BoardModifiers:     boardModifiers,
 		State:              data.ONGOING,
+		Settings:           settings,
+		Scores:             scores,
 	}
 
 	t.Run("ErrorExecContext", func(t *testing.T) {
@@ -155,7 +163,7 @@ func TestTransactional_InsertGame(t *testing.T) {
 		unexpectedError := errors.New("unexpected error")
 		tx := prep.tx(func() {
 			prep.sqlMock.ExpectExec("INSERT INTO games").
-				WithArgs(game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.LetterBank, game.State).
+				WithArgs(game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.BoardModifiers, game.LetterBank, game.State, game.Settings, game.Scores).
 				WillReturnError(unexpectedError)
 		})
 
@@ -167,7 +175,7 @@ func TestTransactional_InsertGame(t *testing.T) {
 
 		tx := prep.tx(func() {
 			prep.sqlMock.ExpectExec("INSERT INTO games").
-				WithArgs(game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.LetterBank, game.State).
+				WithArgs(game.CurrentPlayerOrder, game.NumberOfPlayer, game.BoardBase, game.BoardPositioning, game.BoardModifiers, game.LetterBank, game.State, game.Settings, game.Scores).
 				WillReturnResult(sqlmock.NewResult(int64(gameId), 1))
 		})
 
@@ -402,12 +410,19 @@ func TestTransactional_GetGameById(t *testing.T) {
 		})
 	})
 	t.Run("Success", func(t *testing.T) {
+		previousStateJson, _ := previousState.Value()
+		pendingMovesJson, _ := pendingMoves.Value()
 		expectedGame := data.Game{
 			Id:                 gameId,
 			CurrentPlayerOrder: currentOrder,
 			NumberOfPlayer:     2,
 			BoardBase:          boardBase,
 			BoardPositioning:   boardPositioning,
+			BoardModifiers:     boardModifiers,
+			Settings:           settings,
+			Scores:             scores,
+			PreviousState:      previousState,
+			PendingMoves:       pendingMoves,
 		}
 		testSuite := func(prep Preparation, tx *sql.Tx, gameId data.GameId) {
 			game, err := prep.transactional.GetGameById(prep.ctx, tx, gameId)
@@ -419,13 +434,14 @@ func TestTransactional_GetGameById(t *testing.T) {
 			prep := testPreparation(t)
 
 			tx := prep.tx(func() {
-				prep.sqlMock.ExpectQuery("SELECT (.+) FROM games").
+				prep.sqlMock.ExpectQuery(`SELECT (.+) FROM games WHERE id = \? FOR UPDATE`).
 					WithArgs(gameId).
 					WillReturnRows(
 						sqlmock.NewRows(gameColumn).
 							AddRow(
 								expectedGame.CurrentPlayerOrder, expectedGame.NumberOfPlayer, expectedGame.BoardBase,
-								expectedGame.BoardPositioning, expectedGame.LetterBank, expectedGame.State,
+								expectedGame.BoardPositioning, expectedGame.BoardModifiers, expectedGame.LetterBank, expectedGame.State,
+								[]byte(`{"mode":1}`), []byte(`[3,5]`), previousStateJson, pendingMovesJson,
 							),
 					)
 			})
@@ -435,13 +451,14 @@ func TestTransactional_GetGameById(t *testing.T) {
 		t.Run("WithoutTransaction", func(t *testing.T) {
 			prep := testPreparation(t)
 
-			prep.sqlMock.ExpectQuery("SELECT (.+) FROM games").
+			prep.sqlMock.ExpectQuery(`SELECT (.+) FROM games WHERE id = \?$`).
 				WithArgs(gameId).
 				WillReturnRows(
 					sqlmock.NewRows(gameColumn).
 						AddRow(
 							expectedGame.CurrentPlayerOrder, expectedGame.NumberOfPlayer, expectedGame.BoardBase,
-							expectedGame.BoardPositioning, expectedGame.LetterBank, expectedGame.State,
+							expectedGame.BoardPositioning, expectedGame.BoardModifiers, expectedGame.LetterBank, expectedGame.State,
+							[]byte(`{"mode":1}`), []byte(`[3,5]`), previousStateJson, pendingMovesJson,
 						),
 				)
 
@@ -463,7 +480,7 @@ func TestTransactional_GetGamesByPlayerId(t *testing.T) {
 		_, err := prep.transactional.GetGamesByPlayerId(prep.ctx, playerId)
 		assert.EqualError(t, err, unexpectedError.Error())
 	})
-	gameColumn := []string{"id", "current_player_order", "number_of_player", "board_base", "board_positioning", "state"}
+	gameColumn := []string{"id", "current_player_order", "number_of_player", "board_base", "board_positioning", "board_modifiers", "state", "settings", "scores"}
 	t.Run("ErrorScanning", func(t *testing.T) {
 		prep := testPreparation(t)
 
@@ -471,7 +488,7 @@ func TestTransactional_GetGamesByPlayerId(t *testing.T) {
 			WithArgs(playerId).
 			WillReturnRows(
 				sqlmock.NewRows(gameColumn).
-					AddRow(1, 2, 3, 4, 5, "v"),
+					AddRow(1, 2, 3, 4, 5, nil, "v", nil, nil),
 			)
 
 		_, err := prep.transactional.GetGamesByPlayerId(prep.ctx, playerId)
@@ -487,6 +504,7 @@ func TestTransactional_GetGamesByPlayerId(t *testing.T) {
 			State:              data.ONGOING,
 			BoardBase:          boardBase,
 			BoardPositioning:   boardPositioning,
+			BoardModifiers:     boardModifiers,
 		}
 		prep.sqlMock.ExpectQuery(query).
 			WithArgs(playerId).
@@ -494,7 +512,7 @@ func TestTransactional_GetGamesByPlayerId(t *testing.T) {
 				sqlmock.NewRows(gameColumn).
 					AddRow(
 						expectedGame.Id, expectedGame.CurrentPlayerOrder, expectedGame.NumberOfPlayer, expectedGame.BoardBase,
-						expectedGame.BoardPositioning, expectedGame.State,
+						expectedGame.BoardPositioning, expectedGame.BoardModifiers, expectedGame.State, nil, nil,
 					),
 			)
 
@@ -564,8 +582,8 @@ func TestTransactional_GetPlayedWordsByGameId(t *testing.T) {
 		prep := testPreparation(t)
 
 		playedWords := []data.PlayedWord{
-			{players[0].Id, "KATA"},
-			{players[1].Id, "KITA"},
+			{PlayerId: players[0].Id, Word: "KATA"},
+			{PlayerId: players[1].Id, Word: "KITA"},
 		}
 		prep.sqlMock.ExpectQuery(query).
 			WithArgs(gameId).
@@ -582,6 +600,34 @@ func TestTransactional_GetPlayedWordsByGameId(t *testing.T) {
 	})
 }
 
+func TestTransactional_DeletePlayedWord(t *testing.T) {
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("DELETE FROM played_words").
+				WithArgs(gameId, wordString).
+				WillReturnError(unexpectedError)
+		})
+
+		err := prep.transactional.DeletePlayedWord(prep.ctx, tx, gameId, wordString)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("DELETE FROM played_words").
+				WithArgs(gameId, wordString).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		})
+
+		err := prep.transactional.DeletePlayedWord(prep.ctx, tx, gameId, wordString)
+		assert.NoError(t, err)
+	})
+}
+
 func TestTransactional_UpdateGame(t *testing.T) {
 	t.Run("ErrorExecContext", func(t *testing.T) {
 		prep := testPreparation(t)
@@ -589,14 +635,14 @@ func TestTransactional_UpdateGame(t *testing.T) {
 		unexpectedError := errors.New("unexpected error")
 		tx := prep.tx(func() {
 			prep.sqlMock.ExpectExec("UPDATE games SET").
-				WithArgs(boardPositioning, boardBase, currentOrder, letterBank, data.END, gameId).
+				WithArgs(boardPositioning, boardBase, boardModifiers, currentOrder, letterBank, data.END, scores, previousState, pendingMoves, gameId).
 				WillReturnError(unexpectedError)
 		})
 
 		err := prep.transactional.UpdateGame(
 			prep.ctx, tx, data.Game{
-				Id: gameId, BoardPositioning: boardPositioning, BoardBase: boardBase, CurrentPlayerOrder: currentOrder,
-				LetterBank:letterBank, State: data.END,
+				Id: gameId, BoardPositioning: boardPositioning, BoardBase: boardBase, BoardModifiers: boardModifiers, CurrentPlayerOrder: currentOrder,
+				LetterBank: letterBank, State: data.END, Scores: scores, PreviousState: previousState, PendingMoves: pendingMoves,
 			},
 		)
 		assert.EqualError(t, err, unexpectedError.Error())
@@ -606,20 +652,54 @@ func TestTransactional_UpdateGame(t *testing.T) {
 
 		tx := prep.tx(func() {
 			prep.sqlMock.ExpectExec("UPDATE games SET").
-				WithArgs(boardPositioning, boardBase, currentOrder, letterBank, data.END, gameId).
+				WithArgs(boardPositioning, boardBase, boardModifiers, currentOrder, letterBank, data.END, scores, previousState, pendingMoves, gameId).
 				WillReturnResult(sqlmock.NewResult(time.Now().UnixNano(), 1))
 		})
 
 		err := prep.transactional.UpdateGame(
 			prep.ctx, tx, data.Game{
-				Id: gameId, BoardPositioning: boardPositioning, BoardBase: boardBase, CurrentPlayerOrder: currentOrder,
-				LetterBank: letterBank, State: data.END,
+				Id: gameId, BoardPositioning: boardPositioning, BoardBase: boardBase, BoardModifiers: boardModifiers, CurrentPlayerOrder: currentOrder,
+				LetterBank: letterBank, State: data.END, Scores: scores, PreviousState: previousState, PendingMoves: pendingMoves,
 			},
 		)
 		assert.NoError(t, err)
 	})
 }
 
+func TestTransactional_GetPendingGameIds(t *testing.T) {
+	query := `SELECT id FROM games WHERE pending_moves IS NOT NULL`
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.GetPendingGameIds(prep.ctx)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorScan", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("a"))
+
+		_, err := prep.transactional.GetPendingGameIds(prep.ctx)
+		assert.Error(t, err)
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(gameId))
+
+		gameIds, err := prep.transactional.GetPendingGameIds(prep.ctx)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []data.GameId{gameId}, gameIds)
+		}
+	})
+}
+
 func TestTransactional_UpsertPlayer(t *testing.T) {
 	t.Run("ErrorExecContext", func(t *testing.T) {
 		prep := testPreparation(t)
@@ -689,3 +769,512 @@ func TestTransactional_GetPlayerByDeviceFingerprint(t *testing.T) {
 		}
 	})
 }
+
+var (
+	dispute = data.Dispute{
+		GameId: gameId, PlayerId: playerId, Language: "id-id", Word: wordString,
+		Valid: false, Source: "online", State: data.PENDING, CreatedAt: timestamp.Unix(),
+	}
+	disputeColumn = []string{"id", "game_id", "player_id", "language", "word", "valid", "source", "state", "moderator", "created_at", "decided_at"}
+)
+
+func TestTransactional_InsertDispute(t *testing.T) {
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectExec("INSERT INTO disputes").
+			WithArgs(dispute.GameId, dispute.PlayerId, dispute.Language, dispute.Word, dispute.Valid, dispute.Source, dispute.State, dispute.CreatedAt).
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.InsertDispute(prep.ctx, dispute)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectExec("INSERT INTO disputes").
+			WithArgs(dispute.GameId, dispute.PlayerId, dispute.Language, dispute.Word, dispute.Valid, dispute.Source, dispute.State, dispute.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(7, 1))
+
+		actual, err := prep.transactional.InsertDispute(prep.ctx, dispute)
+		if assert.NoError(t, err) {
+			expected := dispute
+			expected.Id = 7
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func TestTransactional_GetDisputeById(t *testing.T) {
+	query := `SELECT (.+) FROM disputes WHERE id = \? FOR UPDATE`
+	t.Run("ErrorNoRows", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectQuery(query).
+				WithArgs(7).
+				WillReturnRows(sqlmock.NewRows(disputeColumn))
+		})
+
+		_, err := prep.transactional.GetDisputeById(prep.ctx, tx, 7)
+		assert.EqualError(t, err, sql.ErrNoRows.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		expected := dispute
+		expected.Id = 7
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectQuery(query).
+				WithArgs(7).
+				WillReturnRows(
+					sqlmock.NewRows(disputeColumn).
+						AddRow(7, gameId, playerId, "id-id", wordString, false, "online", data.PENDING, "", dispute.CreatedAt, 0),
+				)
+		})
+
+		actual, err := prep.transactional.GetDisputeById(prep.ctx, tx, 7)
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func TestTransactional_GetDisputesByState(t *testing.T) {
+	query := `SELECT (.+) FROM disputes WHERE state = \?`
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING).
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.GetDisputesByState(prep.ctx, data.PENDING)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorScanning", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING).
+			WillReturnRows(
+				sqlmock.NewRows(disputeColumn).
+					AddRow("a", gameId, playerId, "id-id", wordString, false, "online", data.PENDING, "", dispute.CreatedAt, 0),
+			)
+
+		_, err := prep.transactional.GetDisputesByState(prep.ctx, data.PENDING)
+		assert.Error(t, err)
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		expected := dispute
+		expected.Id = 7
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING).
+			WillReturnRows(
+				sqlmock.NewRows(disputeColumn).
+					AddRow(7, gameId, playerId, "id-id", wordString, false, "online", data.PENDING, "", dispute.CreatedAt, 0),
+			)
+
+		actual, err := prep.transactional.GetDisputesByState(prep.ctx, data.PENDING)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []data.Dispute{expected}, actual)
+		}
+	})
+}
+
+func TestTransactional_GetPendingDispute(t *testing.T) {
+	query := `SELECT (.+) FROM disputes WHERE state = \? AND language = \? AND word = \? ORDER BY id LIMIT 1`
+	t.Run("NotExist", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING, "id-id", wordString).
+			WillReturnRows(sqlmock.NewRows(disputeColumn))
+
+		_, exist, err := prep.transactional.GetPendingDispute(prep.ctx, "id-id", wordString)
+		if assert.NoError(t, err) {
+			assert.False(t, exist)
+		}
+	})
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING, "id-id", wordString).
+			WillReturnError(unexpectedError)
+
+		_, _, err := prep.transactional.GetPendingDispute(prep.ctx, "id-id", wordString)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		expected := dispute
+		expected.Id = 7
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(data.PENDING, "id-id", wordString).
+			WillReturnRows(
+				sqlmock.NewRows(disputeColumn).
+					AddRow(7, gameId, playerId, "id-id", wordString, false, "online", data.PENDING, "", dispute.CreatedAt, 0),
+			)
+
+		actual, exist, err := prep.transactional.GetPendingDispute(prep.ctx, "id-id", wordString)
+		if assert.NoError(t, err) && assert.True(t, exist) {
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func TestTransactional_UpdateDispute(t *testing.T) {
+	decided := dispute
+	decided.Id, decided.State, decided.Moderator, decided.DecidedAt = 7, data.APPROVED, "admin", timestamp.Unix()
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("UPDATE disputes SET").
+				WithArgs(data.APPROVED, "admin", decided.DecidedAt, decided.Id).
+				WillReturnError(unexpectedError)
+		})
+
+		err := prep.transactional.UpdateDispute(prep.ctx, tx, decided)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("UPDATE disputes SET").
+				WithArgs(data.APPROVED, "admin", decided.DecidedAt, decided.Id).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		})
+
+		err := prep.transactional.UpdateDispute(prep.ctx, tx, decided)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransactional_UpsertWordOverride(t *testing.T) {
+	override := data.WordOverride{Language: "id-id", Word: wordString, Valid: true, DisputeId: 7}
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("INSERT INTO word_overrides").
+				WithArgs("id-id", wordString, true, 7, true, 7).
+				WillReturnError(unexpectedError)
+		})
+
+		err := prep.transactional.UpsertWordOverride(prep.ctx, tx, override)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		tx := prep.tx(func() {
+			prep.sqlMock.ExpectExec("INSERT INTO word_overrides").
+				WithArgs("id-id", wordString, true, 7, true, 7).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		})
+
+		err := prep.transactional.UpsertWordOverride(prep.ctx, tx, override)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransactional_GetWordOverride(t *testing.T) {
+	query := `SELECT (.+) FROM word_overrides WHERE language = \? AND word = \?`
+	overrideColumn := []string{"language", "word", "valid", "dispute_id"}
+	t.Run("NotExist", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", wordString).
+			WillReturnRows(sqlmock.NewRows(overrideColumn))
+
+		_, exist, err := prep.transactional.GetWordOverride(prep.ctx, "id-id", wordString)
+		if assert.NoError(t, err) {
+			assert.False(t, exist)
+		}
+	})
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", wordString).
+			WillReturnError(unexpectedError)
+
+		_, _, err := prep.transactional.GetWordOverride(prep.ctx, "id-id", wordString)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", wordString).
+			WillReturnRows(sqlmock.NewRows(overrideColumn).AddRow("id-id", wordString, true, 7))
+
+		override, exist, err := prep.transactional.GetWordOverride(prep.ctx, "id-id", wordString)
+		if assert.NoError(t, err) && assert.True(t, exist) {
+			assert.Equal(t, data.WordOverride{Language: "id-id", Word: wordString, Valid: true, DisputeId: 7}, override)
+		}
+	})
+}
+
+var wordList = data.WordList{
+	PlayerId: playerId,
+	Name:     "slang",
+	Words:    data.Words{"anjay", "gokil"},
+}
+
+var wordListColumn = []string{"id", "player_id", "name", "words"}
+
+func TestTransactional_InsertWordList(t *testing.T) {
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectExec("INSERT INTO word_lists").
+			WithArgs(playerId, "slang", []byte(`["anjay","gokil"]`)).
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.InsertWordList(prep.ctx, wordList)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectExec("INSERT INTO word_lists").
+			WithArgs(playerId, "slang", []byte(`["anjay","gokil"]`)).
+			WillReturnResult(sqlmock.NewResult(3, 1))
+
+		actual, err := prep.transactional.InsertWordList(prep.ctx, wordList)
+		if assert.NoError(t, err) {
+			expected := wordList
+			expected.Id = 3
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func TestTransactional_GetWordListById(t *testing.T) {
+	query := `SELECT (.+) FROM word_lists WHERE id = \?`
+	t.Run("ErrorNoRows", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(wordListColumn))
+
+		_, err := prep.transactional.GetWordListById(prep.ctx, 3)
+		assert.EqualError(t, err, sql.ErrNoRows.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(wordListColumn).AddRow(3, playerId, "slang", `["anjay","gokil"]`))
+
+		actual, err := prep.transactional.GetWordListById(prep.ctx, 3)
+		if assert.NoError(t, err) {
+			expected := wordList
+			expected.Id = 3
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func TestTransactional_GetWordListsByPlayerId(t *testing.T) {
+	query := `SELECT (.+) FROM word_lists WHERE player_id = \?`
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(playerId).
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.GetWordListsByPlayerId(prep.ctx, playerId)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorScanning", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(playerId).
+			WillReturnRows(sqlmock.NewRows(wordListColumn).AddRow(3, playerId, "slang", `not json`))
+
+		_, err := prep.transactional.GetWordListsByPlayerId(prep.ctx, playerId)
+		assert.Error(t, err)
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs(playerId).
+			WillReturnRows(sqlmock.NewRows(wordListColumn).AddRow(3, playerId, "slang", `["anjay","gokil"]`))
+
+		actual, err := prep.transactional.GetWordListsByPlayerId(prep.ctx, playerId)
+		if assert.NoError(t, err) {
+			expected := wordList
+			expected.Id = 3
+			assert.Equal(t, []data.WordList{expected}, actual)
+		}
+	})
+}
+
+var lemma = data.Lemma{
+	Language:  "id-id",
+	Word:      "makan",
+	Valid:     true,
+	Source:    "kbbi",
+	FetchedAt: 1760000000,
+}
+
+func TestTransactional_GetLemma(t *testing.T) {
+	query := `SELECT (.+) FROM lemmas WHERE language = \? AND word = \?`
+	lemmaColumn := []string{"language", "word", "valid", "source", "fetched_at", "overridden"}
+	t.Run("NotExist", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", "makan").
+			WillReturnRows(sqlmock.NewRows(lemmaColumn))
+
+		_, exist, err := prep.transactional.GetLemma(prep.ctx, "id-id", "makan")
+		if assert.NoError(t, err) {
+			assert.False(t, exist)
+		}
+	})
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", "makan").
+			WillReturnError(unexpectedError)
+
+		_, _, err := prep.transactional.GetLemma(prep.ctx, "id-id", "makan")
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id", "makan").
+			WillReturnRows(sqlmock.NewRows(lemmaColumn).AddRow("id-id", "makan", true, "kbbi", lemma.FetchedAt, false))
+
+		actual, exist, err := prep.transactional.GetLemma(prep.ctx, "id-id", "makan")
+		if assert.NoError(t, err) && assert.True(t, exist) {
+			assert.Equal(t, lemma, actual)
+		}
+	})
+}
+
+func TestTransactional_UpsertLemma(t *testing.T) {
+	query := `INSERT INTO lemmas (.+) ON DUPLICATE KEY UPDATE (.+) overridden = overridden OR VALUES\(overridden\)`
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectExec(query).
+			WithArgs("id-id", "makan", true, "kbbi", lemma.FetchedAt, false).
+			WillReturnError(unexpectedError)
+
+		err := prep.transactional.UpsertLemma(prep.ctx, lemma)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectExec(query).
+			WithArgs("id-id", "makan", true, "kbbi", lemma.FetchedAt, false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := prep.transactional.UpsertLemma(prep.ctx, lemma)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransactional_DeleteLemma(t *testing.T) {
+	t.Run("ErrorExecContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectExec(`DELETE FROM lemmas WHERE language = \? AND word = \?`).
+			WithArgs("id-id", "makan").
+			WillReturnError(unexpectedError)
+
+		err := prep.transactional.DeleteLemma(prep.ctx, "id-id", "makan")
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectExec(`DELETE FROM lemmas WHERE language = \? AND word = \?`).
+			WithArgs("id-id", "makan").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := prep.transactional.DeleteLemma(prep.ctx, "id-id", "makan")
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransactional_GetValidLemmaWords(t *testing.T) {
+	query := `SELECT word FROM lemmas WHERE language = \? AND valid`
+	t.Run("ErrorQueryContext", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		unexpectedError := errors.New("unexpected error")
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id").
+			WillReturnError(unexpectedError)
+
+		_, err := prep.transactional.GetValidLemmaWords(prep.ctx, "id-id")
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorScan", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id").
+			WillReturnRows(sqlmock.NewRows([]string{"word", "valid"}).AddRow("makan", true))
+
+		_, err := prep.transactional.GetValidLemmaWords(prep.ctx, "id-id")
+		assert.Error(t, err)
+	})
+	t.Run("Success", func(t *testing.T) {
+		prep := testPreparation(t)
+
+		prep.sqlMock.ExpectQuery(query).
+			WithArgs("id-id").
+			WillReturnRows(sqlmock.NewRows([]string{"word"}).AddRow("makan").AddRow("minum"))
+
+		words, err := prep.transactional.GetValidLemmaWords(prep.ctx, "id-id")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"makan", "minum"}, words)
+		}
+	})
+}
+
+func TestTransactional_GetValidOverrideWords(t *testing.T) {
+	prep := testPreparation(t)
+
+	prep.sqlMock.ExpectQuery(`SELECT word FROM word_overrides WHERE language = \? AND valid`).
+		WithArgs("id-id").
+		WillReturnRows(sqlmock.NewRows([]string{"word"}).AddRow("mantul"))
+
+	words, err := prep.transactional.GetValidOverrideWords(prep.ctx, "id-id")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"mantul"}, words)
+	}
+}
@@ -0,0 +1,3 @@
+ALTER TABLE games
+    DROP COLUMN scores,
+    DROP COLUMN settings;
@@ -0,0 +1,3 @@
+ALTER TABLE games
+    ADD COLUMN settings TEXT AFTER state,
+    ADD COLUMN scores   TEXT AFTER settings;
@@ -0,0 +1,2 @@
+ALTER TABLE games
+    ADD COLUMN board_modifiers TINYBLOB AFTER board_positioning;
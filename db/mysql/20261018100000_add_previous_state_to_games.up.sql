@@ -0,0 +1,2 @@
+ALTER TABLE games
+    ADD COLUMN previous_state MEDIUMTEXT AFTER scores;
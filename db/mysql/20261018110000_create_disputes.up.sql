@@ -0,0 +1,15 @@
+create table disputes
+(
+    id         BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    game_id    BIGINT UNSIGNED,
+    player_id  BIGINT UNSIGNED,
+    language   VARCHAR(16),
+    word       VARCHAR(255),
+    valid      BOOLEAN,
+    source     VARCHAR(64),
+    state      TINYINT UNSIGNED DEFAULT 0,
+    moderator  VARCHAR(255) DEFAULT '',
+    created_at BIGINT,
+    decided_at BIGINT DEFAULT 0,
+    index (state)
+);
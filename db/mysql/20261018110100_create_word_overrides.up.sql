@@ -0,0 +1,8 @@
+create table word_overrides
+(
+    language   VARCHAR(16),
+    word       VARCHAR(255),
+    valid      BOOLEAN,
+    dispute_id BIGINT UNSIGNED,
+    primary key (language, word)
+);
@@ -0,0 +1 @@
+drop table word_lists;
@@ -0,0 +1,8 @@
+create table word_lists
+(
+    id        BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    player_id BIGINT UNSIGNED,
+    name      VARCHAR(255),
+    words     JSON,
+    index (player_id)
+);
@@ -0,0 +1,10 @@
+create table lemmas
+(
+    language   VARCHAR(32),
+    word       VARCHAR(255),
+    valid      BOOLEAN,
+    source     VARCHAR(64),
+    fetched_at BIGINT,
+    overridden BOOLEAN DEFAULT false,
+    primary key (language, word)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE games
+    ADD COLUMN pending_moves MEDIUMTEXT AFTER previous_state;
@@ -0,0 +1,217 @@
+package bloom_filter
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// source of the verdicts rejected by the filter
+const source = "bloom_filter"
+
+// metrics holds the stats of each filter, published on /debug/vars
+var metrics = expvar.NewMap("dictionary_bloom_filter")
+
+// Words lists the known valid words of a language, e.g. the words of a word list.
+type Words func(ctx context.Context) ([]string, error)
+
+// Stats tells how the filter fares. FalsePositiveRate is estimated from the bits set,
+// ObservedFalsePositiveRate is the share of the invalid words let through as told by the dictionary.
+type Stats struct {
+	Words                     int     `json:"words"`
+	LoadedAt                  int64   `json:"loaded_at"`
+	Rejected                  uint64  `json:"rejected"`
+	Passed                    uint64  `json:"passed"`
+	FalsePositives            uint64  `json:"false_positives"`
+	FalsePositiveRate         float64 `json:"false_positive_rate"`
+	ObservedFalsePositiveRate float64 `json:"observed_false_positive_rate"`
+}
+
+// BloomFilter rejects the words missing from the known valid words without asking the dictionary,
+// the other words are asked as they may be false positives. The words are only known as of the last load,
+// so the filter is sound before a dictionary knowing no other valid word, like a word list.
+// Until loaded, every word is asked. Without a dictionary the filter only rejects, telling ErrorNotFound
+// on the other words so the next provider of a composite is asked; its false positives are not observed then.
+type BloomFilter struct {
+	name              string
+	dictionary        dictionary.Dictionary
+	words             Words
+	falsePositiveRate float64
+
+	mutex    sync.RWMutex
+	filter   *filter
+	loadedAt time.Time
+
+	rejected       uint64
+	passed         uint64
+	falsePositives uint64
+}
+
+// NewBloomFilter puts the filter before the dictionary, sized to hold the false positive rate.
+// Its stats are published under name.
+func NewBloomFilter(name string, dict dictionary.Dictionary, words Words, falsePositiveRate float64) *BloomFilter {
+	b := &BloomFilter{
+		name:              name,
+		dictionary:        dict,
+		words:             words,
+		falsePositiveRate: falsePositiveRate,
+	}
+	metrics.Set(name, expvar.Func(func() interface{} {
+		return b.Stats()
+	}))
+	return b
+}
+
+// Load builds the filter from the known valid words, replacing the previous one.
+func (b *BloomFilter) Load(ctx context.Context) error {
+	words, err := b.words(ctx)
+	if err != nil {
+		return err
+	}
+	f := newFilter(len(words), b.falsePositiveRate)
+	for _, word := range words {
+		f.add(word)
+	}
+
+	b.mutex.Lock()
+	b.filter = f
+	b.loadedAt = time.Now()
+	b.mutex.Unlock()
+	log.Printf("bloom filter of %v loaded with %v words, false positive rate %.4f", b.name, f.words, f.falsePositiveRate())
+	return nil
+}
+
+// Refresh loads the filter again on every interval until the context is done.
+// A failed load keeps the previous filter.
+func (b *BloomFilter) Refresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.Load(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("bloom filter refresh failed, keeping the previous one: %v", err)
+			}
+		}
+	}
+}
+
+func (b *BloomFilter) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	verdict, err := b.Verdict(ctx, lemma)
+	return verdict.Valid, err
+}
+
+// Verdict rejects the lemma missing from the filter, the source being bloom_filter, otherwise asks the dictionary.
+func (b *BloomFilter) Verdict(ctx context.Context, lemma string) (verdict dictionary.Verdict, err error) {
+	contained, filtered := b.mayContain(lemma)
+	if !contained {
+		return dictionary.Verdict{Source: source}, nil
+	}
+	if b.dictionary == nil {
+		return verdict, dictionary.ErrorNotFound
+	}
+	if arbiter, ok := b.dictionary.(dictionary.Arbiter); ok {
+		verdict, err = arbiter.Verdict(ctx, lemma)
+	} else {
+		verdict.Valid, err = b.dictionary.LemmaIsValid(ctx, lemma)
+	}
+	if err == nil && filtered {
+		b.observe(verdict.Valid)
+	}
+	return
+}
+
+// LemmasAreValid rejects the lemmas missing from the filter and asks the dictionary about the others at once,
+// leaving them out without a dictionary.
+func (b *BloomFilter) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	rejected := make([]string, 0, len(lemmas))
+	asked := make([]string, 0, len(lemmas))
+	filtered := false
+	for _, lemma := range lemmas {
+		var contained bool
+		contained, filtered = b.mayContain(lemma)
+		if contained {
+			asked = append(asked, lemma)
+		} else {
+			rejected = append(rejected, lemma)
+		}
+	}
+
+	verdicts := make(map[string]dictionary.Verdict, len(lemmas))
+	if len(asked) > 0 && b.dictionary != nil {
+		answers, err := dictionary.LemmasAreValid(ctx, b.dictionary, asked)
+		if err != nil {
+			return nil, err
+		}
+		for lemma, verdict := range answers {
+			if filtered {
+				b.observe(verdict.Valid)
+			}
+			verdicts[lemma] = verdict
+		}
+	}
+	for _, lemma := range rejected {
+		verdicts[lemma] = dictionary.Verdict{Source: source}
+	}
+	return verdicts, nil
+}
+
+// Define asks the dictionary, the filter only knows the valid words.
+func (b *BloomFilter) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	definer, ok := b.dictionary.(dictionary.Definer)
+	if !ok {
+		return data.Entry{}, dictionary.ErrorNotFound
+	}
+	return definer.Define(ctx, lemma)
+}
+
+func (b *BloomFilter) Stats() Stats {
+	stats := Stats{
+		Rejected:       atomic.LoadUint64(&b.rejected),
+		Passed:         atomic.LoadUint64(&b.passed),
+		FalsePositives: atomic.LoadUint64(&b.falsePositives),
+	}
+	if invalid := stats.Rejected + stats.FalsePositives; invalid > 0 {
+		stats.ObservedFalsePositiveRate = float64(stats.FalsePositives) / float64(invalid)
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	if b.filter != nil {
+		stats.Words = b.filter.words
+		stats.LoadedAt = b.loadedAt.Unix()
+		stats.FalsePositiveRate = b.filter.falsePositiveRate()
+	}
+	return stats
+}
+
+// mayContain tells whether the lemma is to be asked, and whether it was filtered as the filter may not be loaded yet.
+func (b *BloomFilter) mayContain(lemma string) (contained bool, filtered bool) {
+	b.mutex.RLock()
+	f := b.filter
+	b.mutex.RUnlock()
+	if f == nil {
+		return true, false
+	}
+	if !f.mayContain(lemma) {
+		atomic.AddUint64(&b.rejected, 1)
+		return false, true
+	}
+	atomic.AddUint64(&b.passed, 1)
+	return true, true
+}
+
+// observe counts the false positives, the words passed by the filter but invalid.
+func (b *BloomFilter) observe(valid bool) {
+	if !valid {
+		atomic.AddUint64(&b.falsePositives, 1)
+	}
+}
@@ -0,0 +1,220 @@
+package bloom_filter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/bloom_filter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type Dictionary struct {
+	mock.Mock
+}
+
+func (d *Dictionary) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	args := d.Called(lemma)
+	return args.Bool(0), args.Error(1)
+}
+
+func (d *Dictionary) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	args := d.Called(lemma)
+	return args.Get(0).(data.Entry), args.Error(1)
+}
+
+// valid is a dictionary in memory
+type valid map[string]bool
+
+func (v valid) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	return v[lemma], nil
+}
+
+var ctx = context.Background()
+
+func words(words ...string) bloom_filter.Words {
+	return func(ctx context.Context) ([]string, error) {
+		return words, nil
+	}
+}
+
+func suiteBloomFilter(t *testing.T, dict dictionary.Dictionary, known ...string) *bloom_filter.BloomFilter {
+	bloomFilter := bloom_filter.NewBloomFilter(t.Name(), dict, words(known...), 0.01)
+	if !assert.NoError(t, bloomFilter.Load(ctx)) {
+		t.FailNow()
+	}
+	return bloomFilter
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	dict := make(valid)
+	known := make([]string, 10000)
+	for i := range known {
+		known[i] = fmt.Sprintf("valid%v", i)
+		dict[known[i]] = true
+	}
+	bloomFilter := suiteBloomFilter(t, dict, known...)
+
+	for _, word := range known {
+		verdict, err := bloomFilter.Verdict(ctx, word)
+		if assert.NoError(t, err) && !assert.True(t, verdict.Valid, "no false negative") {
+			t.FailNow()
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		verdict, err := bloomFilter.Verdict(ctx, fmt.Sprintf("invalid%v", i))
+		if assert.NoError(t, err) {
+			assert.False(t, verdict.Valid)
+		}
+	}
+
+	stats := bloomFilter.Stats()
+	assert.Equal(t, 10000, stats.Words)
+	assert.Equal(t, uint64(20000), stats.Rejected+stats.Passed)
+	assert.Equal(t, stats.Passed-10000, stats.FalsePositives)
+	assert.InDelta(t, 0.01, stats.FalsePositiveRate, 0.005)
+	assert.InDelta(t, 0.01, stats.ObservedFalsePositiveRate, 0.01)
+}
+
+func TestBloomFilter_Verdict(t *testing.T) {
+	t.Run("Rejected", func(t *testing.T) {
+		dict := &Dictionary{}
+		bloomFilter := suiteBloomFilter(t, dict, "makan")
+
+		verdict, err := bloomFilter.Verdict(ctx, "mkn")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: false, Source: "bloom_filter"}, verdict)
+		}
+		dict.AssertNotCalled(t, "LemmaIsValid", "mkn")
+	})
+	t.Run("Passed", func(t *testing.T) {
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "makan").Return(true, nil)
+		bloomFilter := suiteBloomFilter(t, dict, "makan")
+
+		result, err := bloomFilter.LemmaIsValid(ctx, "makan")
+		if assert.NoError(t, err) {
+			assert.True(t, result)
+			assert.Equal(t, uint64(1), bloomFilter.Stats().Passed)
+		}
+	})
+	t.Run("NotLoaded", func(t *testing.T) {
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "mkn").Return(false, nil)
+		bloomFilter := bloom_filter.NewBloomFilter(t.Name(), dict, words("makan"), 0.01)
+
+		result, err := bloomFilter.LemmaIsValid(ctx, "mkn")
+		if assert.NoError(t, err) {
+			assert.False(t, result)
+			assert.Equal(t, bloom_filter.Stats{}, bloomFilter.Stats(), "nothing filtered")
+		}
+	})
+	t.Run("PassedWithoutDictionary", func(t *testing.T) {
+		bloomFilter := suiteBloomFilter(t, nil, "makan")
+
+		_, err := bloomFilter.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error(), "asked to the next provider")
+		verdict, err := bloomFilter.Verdict(ctx, "mkn")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: false, Source: "bloom_filter"}, verdict)
+		}
+	})
+	t.Run("ErrorDictionary", func(t *testing.T) {
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "makan").Return(false, dictionary.ErrorProviderUnavailable)
+		bloomFilter := suiteBloomFilter(t, dict, "makan")
+
+		_, err := bloomFilter.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+		assert.Zero(t, bloomFilter.Stats().FalsePositives)
+	})
+}
+
+func TestBloomFilter_LemmasAreValid(t *testing.T) {
+	dict := valid{"makan": true}
+	bloomFilter := suiteBloomFilter(t, dict, "makan", "minum")
+
+	verdicts, err := bloomFilter.LemmasAreValid(ctx, []string{"makan", "minum", "mkn"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]dictionary.Verdict{
+			"makan": {Valid: true},
+			"minum": {Valid: false},
+			"mkn":   {Valid: false, Source: "bloom_filter"},
+		}, verdicts)
+		assert.Equal(t, uint64(1), bloomFilter.Stats().FalsePositives, "minum is not valid after all")
+	}
+}
+
+func TestBloomFilter_LemmasAreValidWithoutDictionary(t *testing.T) {
+	bloomFilter := suiteBloomFilter(t, nil, "makan")
+
+	verdicts, err := bloomFilter.LemmasAreValid(ctx, []string{"makan", "mkn"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]dictionary.Verdict{
+			"mkn": {Valid: false, Source: "bloom_filter"},
+		}, verdicts, "makan is left to the next provider")
+	}
+}
+
+func TestBloomFilter_Load(t *testing.T) {
+	t.Run("ErrorKeepsPrevious", func(t *testing.T) {
+		dict := &Dictionary{}
+		loaded := false
+		bloomFilter := bloom_filter.NewBloomFilter(t.Name(), dict, func(ctx context.Context) ([]string, error) {
+			if loaded {
+				return nil, errors.New("unexpected error")
+			}
+			loaded = true
+			return []string{"makan"}, nil
+		}, 0.01)
+
+		assert.NoError(t, bloomFilter.Load(ctx))
+		assert.EqualError(t, bloomFilter.Load(ctx), "unexpected error")
+		assert.Equal(t, 1, bloomFilter.Stats().Words)
+	})
+}
+
+func TestBloomFilter_Refresh(t *testing.T) {
+	dict := &Dictionary{}
+	known := make(chan []string, 1)
+	known <- []string{"makan", "minum"}
+	bloomFilter := bloom_filter.NewBloomFilter(t.Name(), dict, func(ctx context.Context) ([]string, error) {
+		select {
+		case words := <-known:
+			return words, nil
+		default:
+			return nil, errors.New("unchanged")
+		}
+	}, 0.01)
+
+	refreshCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go bloomFilter.Refresh(refreshCtx, time.Millisecond)
+
+	deadline := time.Now().Add(time.Second)
+	for bloomFilter.Stats().Words != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, 2, bloomFilter.Stats().Words)
+}
+
+func TestBloomFilter_Define(t *testing.T) {
+	entry := data.Entry{Lemma: "makan", Class: "Verba", Definitions: []string{"memasukkan makanan"}}
+	dict := &Dictionary{}
+	dict.On("Define", "makan").Return(entry, nil)
+	bloomFilter := suiteBloomFilter(t, dict, "makan")
+
+	result, err := bloomFilter.Define(ctx, "makan")
+	if assert.NoError(t, err) {
+		assert.Equal(t, entry, result)
+	}
+
+	_, err = suiteBloomFilter(t, valid{}, "makan").Define(ctx, "makan")
+	assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+}
@@ -0,0 +1,70 @@
+package bloom_filter
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// filter is a Bloom filter of words, each word setting k bits located by double hashing.
+type filter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+	words  int
+}
+
+// newFilter sizes the filter for the words to hold the false positive rate,
+// with m = -n ln p / (ln 2)^2 bits and k = m / n ln 2 hashes.
+func newFilter(words int, falsePositiveRate float64) *filter {
+	n := float64(words)
+	if n < 1 {
+		n = 1
+	}
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+	blocks := (uint64(m) + 63) / 64
+	return &filter{
+		bits:   make([]uint64, blocks),
+		size:   blocks * 64,
+		hashes: uint64(k),
+	}
+}
+
+func (f *filter) add(word string) {
+	h1, h2 := hash(word)
+	for i := uint64(0); i < f.hashes; i++ {
+		location := (h1 + i*h2) % f.size
+		f.bits[location/64] |= 1 << (location % 64)
+	}
+	f.words++
+}
+
+func (f *filter) mayContain(word string) bool {
+	h1, h2 := hash(word)
+	for i := uint64(0); i < f.hashes; i++ {
+		location := (h1 + i*h2) % f.size
+		if f.bits[location/64]&(1<<(location%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// falsePositiveRate estimates the rate from the share of the bits set, raised to k.
+func (f *filter) falsePositiveRate() float64 {
+	set := 0
+	for _, block := range f.bits {
+		set += bits.OnesCount64(block)
+	}
+	return math.Pow(float64(set)/float64(f.size), float64(f.hashes))
+}
+
+// hash gives the two hashes of the word, FNV-1a and FNV-1, the second one never zero.
+func hash(word string) (uint64, uint64) {
+	h1 := fnv.New64a()
+	_, _ = h1.Write([]byte(word))
+	h2 := fnv.New64()
+	_, _ = h2.Write([]byte(word))
+	return h1.Sum64(), h2.Sum64() | 1
+}
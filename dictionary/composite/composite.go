@@ -0,0 +1,266 @@
+package composite
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"sync"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+var metrics = expvar.NewMap("dictionary_composite")
+
+// Provider is one dictionary in the chain. An authoritative answer ends the lookup,
+// otherwise the next provider is asked.
+type Provider struct {
+	Name                  string
+	Dictionary            dictionary.Dictionary
+	PositiveAuthoritative bool
+	NegativeAuthoritative bool
+}
+
+func (p Provider) authoritative(valid bool) bool {
+	if valid {
+		return p.PositiveAuthoritative
+	}
+	return p.NegativeAuthoritative
+}
+
+// Composite queries its providers in order until one of them answers authoritatively.
+// Provider errors fall through to the next provider, so an outage of one of them
+// does not block words already known by the others.
+type Composite struct {
+	providers []Provider
+	mutex     sync.Mutex
+	decisions map[string]uint64
+}
+
+func NewComposite(providers ...Provider) *Composite {
+	return &Composite{
+		providers: providers,
+		decisions: make(map[string]uint64),
+	}
+}
+
+func (c *Composite) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	verdict, err := c.Verdict(ctx, lemma)
+	return verdict.Valid, err
+}
+
+// Verdict looks up the lemma and tells which provider decided it.
+// When nobody answers authoritatively, the first non authoritative answer is used,
+// unless a provider failed as it could have decided otherwise.
+// Without a verdict, ErrorRateLimited is returned if a provider was rate limited,
+// otherwise ErrorProviderUnavailable.
+func (c *Composite) Verdict(ctx context.Context, lemma string) (verdict dictionary.Verdict, err error) {
+	var fallback *dictionary.Verdict
+	failed := false
+	err = dictionary.ErrorProviderUnavailable
+	for _, provider := range c.providers {
+		valid, providerErr := provider.Dictionary.LemmaIsValid(ctx, lemma)
+		if providerErr == dictionary.ErrorNotFound {
+			continue
+		}
+		if providerErr != nil {
+			if ctx.Err() != nil {
+				return verdict, ctx.Err()
+			}
+			log.Printf("dictionary provider %v failed on %v: %v", provider.Name, lemma, providerErr)
+			failed = true
+			if providerErr == dictionary.ErrorRateLimited {
+				err = providerErr
+			}
+			continue
+		}
+
+		answer := dictionary.Verdict{Valid: valid, Source: provider.Name}
+		if provider.authoritative(valid) {
+			c.record(answer.Source)
+			return answer, nil
+		}
+		if fallback == nil {
+			fallback = &answer
+		}
+	}
+
+	if fallback != nil && !failed {
+		c.record(fallback.Source)
+		return *fallback, nil
+	}
+	return
+}
+
+// LemmasAreValid looks the lemmas up as Verdict does, asking each provider at once
+// for the lemmas left undecided by the previous ones. When a lemma is left without any answer,
+// or with a non authoritative answer while a provider failed on it, no verdict is returned and the error is as of Verdict.
+func (c *Composite) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	verdicts := make(map[string]dictionary.Verdict, len(lemmas))
+	fallbacks := make(map[string]dictionary.Verdict)
+	failed := make(map[string]bool)
+	undecided := unique(lemmas)
+	err := dictionary.ErrorProviderUnavailable
+	for _, provider := range c.providers {
+		if len(undecided) == 0 {
+			break
+		}
+		answers, providerErr := dictionary.LemmasAreValid(ctx, provider.Dictionary, undecided)
+		if providerErr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			log.Printf("dictionary provider %v failed on %v lemmas: %v", provider.Name, len(undecided), providerErr)
+			for _, lemma := range undecided {
+				failed[lemma] = true
+			}
+			if providerErr == dictionary.ErrorRateLimited {
+				err = providerErr
+			}
+			continue
+		}
+
+		remaining := make([]string, 0, len(undecided))
+		for _, lemma := range undecided {
+			answer, ok := answers[lemma]
+			if !ok {
+				remaining = append(remaining, lemma)
+				continue
+			}
+			answer.Source = provider.Name
+			if provider.authoritative(answer.Valid) {
+				c.record(answer.Source)
+				verdicts[lemma] = answer
+				continue
+			}
+			if _, ok := fallbacks[lemma]; !ok {
+				fallbacks[lemma] = answer
+			}
+			remaining = append(remaining, lemma)
+		}
+		undecided = remaining
+	}
+
+	for _, lemma := range undecided {
+		fallback, ok := fallbacks[lemma]
+		if !ok || failed[lemma] {
+			return nil, err
+		}
+		c.record(fallback.Source)
+		verdicts[lemma] = fallback
+	}
+	return verdicts, nil
+}
+
+// Define asks the providers able to define, in order, for the first entry of the lemma.
+func (c *Composite) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	err := dictionary.ErrorNotFound
+	for _, provider := range c.providers {
+		definer, ok := provider.Dictionary.(dictionary.Definer)
+		if !ok {
+			continue
+		}
+		entry, providerErr := definer.Define(ctx, lemma)
+		if providerErr == nil {
+			return entry, nil
+		}
+		if ctx.Err() != nil {
+			return data.Entry{}, ctx.Err()
+		}
+		if providerErr != dictionary.ErrorNotFound {
+			log.Printf("dictionary provider %v failed to define %v: %v", provider.Name, lemma, providerErr)
+			err = dictionary.ErrorProviderUnavailable
+		}
+	}
+	return data.Entry{}, err
+}
+
+// Decisions counts the lookups decided by each provider.
+func (c *Composite) Decisions() map[string]uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	decisions := make(map[string]uint64, len(c.decisions))
+	for source, count := range c.decisions {
+		decisions[source] = count
+	}
+	return decisions
+}
+
+// Publish puts the decisions on expvar under name, e.g. the dictionary language.
+func (c *Composite) Publish(name string) *Composite {
+	metrics.Set(name, expvar.Func(func() interface{} {
+		return c.Decisions()
+	}))
+	return c
+}
+
+func (c *Composite) record(source string) {
+	c.mutex.Lock()
+	c.decisions[source]++
+	c.mutex.Unlock()
+}
+
+func unique(lemmas []string) []string {
+	seen := make(map[string]bool, len(lemmas))
+	result := make([]string, 0, len(lemmas))
+	for _, lemma := range lemmas {
+		if seen[lemma] {
+			continue
+		}
+		seen[lemma] = true
+		result = append(result, lemma)
+	}
+	return result
+}
+
+// Cache adapts the verdict cache as a provider, answering ErrorNotFound on a miss.
+type Cache struct {
+	cache    data.Dictionary
+	language string
+}
+
+func NewCache(cache data.Dictionary, language string) *Cache {
+	return &Cache{
+		cache:    cache,
+		language: language,
+	}
+}
+
+func (c *Cache) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	result, exist, err := c.cache.Get(ctx, c.language, lemma)
+	if err != nil {
+		log.Printf("dictionary cache failed on %v: %v", lemma, err)
+		return false, dictionary.ErrorProviderUnavailable
+	}
+	if !exist {
+		return false, dictionary.ErrorNotFound
+	}
+	return result, nil
+}
+
+// LemmasAreValid gets the cached verdicts at once, the misses are missing from the verdicts.
+func (c *Cache) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	results, err := c.cache.GetMany(ctx, c.language, lemmas)
+	if err != nil {
+		log.Printf("dictionary cache failed on %v lemmas: %v", len(lemmas), err)
+		return nil, dictionary.ErrorProviderUnavailable
+	}
+	verdicts := make(map[string]dictionary.Verdict, len(results))
+	for lemma, valid := range results {
+		verdicts[lemma] = dictionary.Verdict{Valid: valid}
+	}
+	return verdicts, nil
+}
+
+func (c *Cache) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	entry, exist, err := c.cache.GetEntry(ctx, c.language, lemma)
+	if err != nil {
+		log.Printf("dictionary cache failed on %v: %v", lemma, err)
+		return data.Entry{}, dictionary.ErrorProviderUnavailable
+	}
+	if !exist {
+		return data.Entry{}, dictionary.ErrorNotFound
+	}
+	return entry, nil
+}
@@ -0,0 +1,430 @@
+package composite_test
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/composite"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type Dictionary struct {
+	mock.Mock
+}
+
+func (d *Dictionary) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	args := d.Called(lemma)
+	return args.Bool(0), args.Error(1)
+}
+
+type DataDictionary struct {
+	mock.Mock
+}
+
+func (d *DataDictionary) Get(ctx context.Context, lang, key string) (bool, bool, error) {
+	args := d.Called(lang, key)
+	return args.Bool(0), args.Bool(1), args.Error(2)
+}
+
+func (d *DataDictionary) GetMany(ctx context.Context, lang string, keys []string) (map[string]bool, error) {
+	args := d.Called(lang, keys)
+	results, _ := args.Get(0).(map[string]bool)
+	return results, args.Error(1)
+}
+
+func (d *DataDictionary) Set(ctx context.Context, lang, key string, value bool, source string) error {
+	return d.Called(lang, key, value).Error(0)
+}
+
+func (d *DataDictionary) GetEntry(ctx context.Context, lang, key string) (data.Entry, bool, error) {
+	args := d.Called(lang, key)
+	return args.Get(0).(data.Entry), args.Bool(1), args.Error(2)
+}
+
+func (d *DataDictionary) SetEntry(ctx context.Context, lang, key string, entry data.Entry) error {
+	return d.Called(lang, key, entry).Error(0)
+}
+
+type Definer struct {
+	Dictionary
+}
+
+func (d *Definer) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	args := d.Called(lemma)
+	return args.Get(0).(data.Entry), args.Error(1)
+}
+
+type Batch struct {
+	Dictionary
+}
+
+func (b *Batch) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	args := b.Called(lemmas)
+	verdicts, _ := args.Get(0).(map[string]dictionary.Verdict)
+	return verdicts, args.Error(1)
+}
+
+var ctx = context.Background()
+
+func provider(name string, valid bool, err error, positive, negative bool) composite.Provider {
+	dict := &Dictionary{}
+	dict.On("LemmaIsValid", "word").Return(valid, err)
+	return composite.Provider{
+		Name:                  name,
+		Dictionary:            dict,
+		PositiveAuthoritative: positive,
+		NegativeAuthoritative: negative,
+	}
+}
+
+func TestComposite_Verdict(t *testing.T) {
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("FirstAuthoritative", func(t *testing.T) {
+		unused := &Dictionary{}
+		comp := composite.NewComposite(
+			provider("word_list", true, nil, true, false),
+			composite.Provider{Name: "kbbi", Dictionary: unused},
+		)
+
+		verdict, err := comp.Verdict(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: true, Source: "word_list"}, verdict)
+		}
+		unused.AssertNotCalled(t, "LemmaIsValid", "word")
+	})
+	t.Run("NonAuthoritativeFallsThrough", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("word_list", false, nil, true, false),
+			provider("kbbi", true, nil, true, true),
+		)
+
+		verdict, err := comp.Verdict(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: true, Source: "kbbi"}, verdict)
+		}
+	})
+	t.Run("NotFoundFallsThrough", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("cache", false, dictionary.ErrorNotFound, true, true),
+			provider("kbbi", false, nil, true, true),
+		)
+
+		verdict, err := comp.Verdict(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: false, Source: "kbbi"}, verdict)
+		}
+	})
+	t.Run("ErrorFallsThrough", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("kbbi", false, unexpectedError, true, true),
+			provider("word_list", true, nil, true, false),
+		)
+
+		verdict, err := comp.Verdict(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: true, Source: "word_list"}, verdict)
+		}
+	})
+	t.Run("FallbackToNonAuthoritative", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("word_list", false, nil, true, false),
+			provider("kbbi", false, dictionary.ErrorNotFound, true, true),
+		)
+
+		verdict, err := comp.Verdict(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: false, Source: "word_list"}, verdict)
+		}
+	})
+	t.Run("ErrorNoFallbackOnFailure", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("word_list", false, nil, true, false),
+			provider("kbbi", false, unexpectedError, true, true),
+		)
+
+		_, err := comp.Verdict(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+		assert.Equal(t, map[string]uint64{}, comp.Decisions())
+	})
+	t.Run("ErrorNoAnswer", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("cache", false, dictionary.ErrorNotFound, true, true),
+			provider("kbbi", false, unexpectedError, true, true),
+		)
+
+		_, err := comp.Verdict(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+	t.Run("ErrorRateLimited", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("kbbi", false, dictionary.ErrorRateLimited, true, true),
+			provider("backup", false, unexpectedError, true, true),
+		)
+
+		_, err := comp.Verdict(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorRateLimited.Error())
+	})
+	t.Run("ErrorContextCanceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		unused := &Dictionary{}
+		comp := composite.NewComposite(
+			provider("kbbi", false, context.Canceled, true, true),
+			composite.Provider{Name: "backup", Dictionary: unused},
+		)
+
+		_, err := comp.Verdict(canceledCtx, "word")
+		assert.EqualError(t, err, context.Canceled.Error())
+		unused.AssertNotCalled(t, "LemmaIsValid", "word")
+	})
+	t.Run("ErrorNoProvider", func(t *testing.T) {
+		_, err := composite.NewComposite().Verdict(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
+
+func TestComposite_LemmaIsValid(t *testing.T) {
+	comp := composite.NewComposite(
+		provider("word_list", false, nil, true, false),
+		provider("kbbi", true, nil, true, true),
+	)
+
+	result, err := comp.LemmaIsValid(ctx, "word")
+	if assert.NoError(t, err) {
+		assert.True(t, result)
+	}
+}
+
+func TestComposite_Decisions(t *testing.T) {
+	comp := composite.NewComposite(
+		provider("word_list", false, nil, true, false),
+		provider("kbbi", true, nil, true, true),
+	)
+
+	_, _ = comp.LemmaIsValid(ctx, "word")
+	_, _ = comp.LemmaIsValid(ctx, "word")
+
+	assert.Equal(t, map[string]uint64{"kbbi": 2}, comp.Decisions())
+
+	t.Run("Published", func(t *testing.T) {
+		comp.Publish("xx-xx")
+
+		published := expvar.Get("dictionary_composite").(*expvar.Map).Get("xx-xx")
+		if assert.NotNil(t, published) {
+			assert.JSONEq(t, `{"kbbi": 2}`, published.String())
+		}
+	})
+}
+
+func TestCache_LemmaIsValid(t *testing.T) {
+	t.Run("Exist", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("Get", "id-id", "word").Return(true, true, nil)
+
+		result, err := composite.NewCache(dataDictionary, "id-id").LemmaIsValid(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.True(t, result)
+		}
+	})
+	t.Run("NotExist", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("Get", "id-id", "word").Return(false, false, nil)
+
+		_, err := composite.NewCache(dataDictionary, "id-id").LemmaIsValid(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+	t.Run("ErrorUnreachable", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("Get", "id-id", "word").Return(false, false, errors.New("unexpected error"))
+
+		_, err := composite.NewCache(dataDictionary, "id-id").LemmaIsValid(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
+
+func TestComposite_LemmasAreValid(t *testing.T) {
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("UndecidedFallThrough", func(t *testing.T) {
+		cache := &Batch{}
+		cache.On("LemmasAreValid", []string{"makan", "mkn", "hilang"}).
+			Return(map[string]dictionary.Verdict{"makan": {Valid: true}}, nil)
+		wordList := &Dictionary{}
+		wordList.On("LemmaIsValid", "mkn").Return(false, nil)
+		wordList.On("LemmaIsValid", "hilang").Return(true, nil)
+		kbbi := &Batch{}
+		kbbi.On("LemmasAreValid", []string{"mkn"}).
+			Return(map[string]dictionary.Verdict{"mkn": {Valid: false, Source: "kbbi"}}, nil)
+		comp := composite.NewComposite(
+			composite.Provider{Name: "cache", Dictionary: cache, PositiveAuthoritative: true, NegativeAuthoritative: true},
+			composite.Provider{Name: "word_list", Dictionary: wordList, PositiveAuthoritative: true},
+			composite.Provider{Name: "kbbi", Dictionary: kbbi, PositiveAuthoritative: true, NegativeAuthoritative: true},
+		)
+
+		verdicts, err := comp.LemmasAreValid(ctx, []string{"makan", "mkn", "hilang", "makan"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{
+				"makan":  {Valid: true, Source: "cache"},
+				"mkn":    {Valid: false, Source: "kbbi"},
+				"hilang": {Valid: true, Source: "word_list"},
+			}, verdicts)
+		}
+		assert.Equal(t, map[string]uint64{"cache": 1, "word_list": 1, "kbbi": 1}, comp.Decisions())
+	})
+	t.Run("FallbackToNonAuthoritative", func(t *testing.T) {
+		wordList := &Batch{}
+		wordList.On("LemmasAreValid", []string{"mkn"}).
+			Return(map[string]dictionary.Verdict{"mkn": {Valid: false}}, nil)
+		kbbi := &Batch{}
+		kbbi.On("LemmasAreValid", []string{"mkn"}).Return(map[string]dictionary.Verdict{}, nil)
+		comp := composite.NewComposite(
+			composite.Provider{Name: "word_list", Dictionary: wordList, PositiveAuthoritative: true},
+			composite.Provider{Name: "kbbi", Dictionary: kbbi, PositiveAuthoritative: true, NegativeAuthoritative: true},
+		)
+
+		verdicts, err := comp.LemmasAreValid(ctx, []string{"mkn"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{"mkn": {Valid: false, Source: "word_list"}}, verdicts)
+		}
+	})
+	t.Run("ErrorNoFallbackOnFailure", func(t *testing.T) {
+		wordList := &Batch{}
+		wordList.On("LemmasAreValid", []string{"makan", "mkn"}).
+			Return(map[string]dictionary.Verdict{"makan": {Valid: true}, "mkn": {Valid: false}}, nil)
+		kbbi := &Batch{}
+		kbbi.On("LemmasAreValid", []string{"mkn"}).Return(nil, unexpectedError)
+		comp := composite.NewComposite(
+			composite.Provider{Name: "word_list", Dictionary: wordList, PositiveAuthoritative: true},
+			composite.Provider{Name: "kbbi", Dictionary: kbbi, PositiveAuthoritative: true, NegativeAuthoritative: true},
+		)
+
+		_, err := comp.LemmasAreValid(ctx, []string{"makan", "mkn"})
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+	t.Run("ErrorRateLimited", func(t *testing.T) {
+		cache := &Batch{}
+		cache.On("LemmasAreValid", []string{"makan", "mkn"}).
+			Return(map[string]dictionary.Verdict{"makan": {Valid: true}}, nil)
+		kbbi := &Batch{}
+		kbbi.On("LemmasAreValid", []string{"mkn"}).Return(nil, dictionary.ErrorRateLimited)
+		comp := composite.NewComposite(
+			composite.Provider{Name: "cache", Dictionary: cache, PositiveAuthoritative: true, NegativeAuthoritative: true},
+			composite.Provider{Name: "kbbi", Dictionary: kbbi, PositiveAuthoritative: true, NegativeAuthoritative: true},
+		)
+
+		_, err := comp.LemmasAreValid(ctx, []string{"makan", "mkn"})
+		assert.EqualError(t, err, dictionary.ErrorRateLimited.Error())
+	})
+	t.Run("ErrorContextCanceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		kbbi := &Batch{}
+		kbbi.On("LemmasAreValid", []string{"mkn"}).Return(nil, context.Canceled)
+		unused := &Batch{}
+		comp := composite.NewComposite(
+			composite.Provider{Name: "kbbi", Dictionary: kbbi},
+			composite.Provider{Name: "backup", Dictionary: unused},
+		)
+
+		_, err := comp.LemmasAreValid(canceledCtx, []string{"mkn"})
+		assert.EqualError(t, err, context.Canceled.Error())
+		unused.AssertNotCalled(t, "LemmasAreValid", []string{"mkn"})
+	})
+}
+
+func TestCache_LemmasAreValid(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("GetMany", "id-id", []string{"makan", "mkn", "hilang"}).
+			Return(map[string]bool{"makan": true, "mkn": false}, nil)
+
+		verdicts, err := composite.NewCache(dataDictionary, "id-id").LemmasAreValid(ctx, []string{"makan", "mkn", "hilang"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{
+				"makan": {Valid: true},
+				"mkn":   {Valid: false},
+			}, verdicts)
+		}
+	})
+	t.Run("ErrorUnreachable", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("GetMany", "id-id", []string{"makan"}).Return(nil, errors.New("unexpected error"))
+
+		_, err := composite.NewCache(dataDictionary, "id-id").LemmasAreValid(ctx, []string{"makan"})
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
+
+func TestComposite_Define(t *testing.T) {
+	entry := data.Entry{Lemma: "word", Class: "Nomina", Definitions: []string{"kata"}}
+	definer := func(entry data.Entry, err error) composite.Provider {
+		dict := &Definer{}
+		dict.On("Define", "word").Return(entry, err)
+		return composite.Provider{Name: "definer", Dictionary: dict}
+	}
+
+	t.Run("FirstDefining", func(t *testing.T) {
+		comp := composite.NewComposite(
+			provider("word_list", true, nil, true, false),
+			definer(data.Entry{}, dictionary.ErrorNotFound),
+			definer(entry, nil),
+		)
+
+		result, err := comp.Define(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, entry, result)
+		}
+	})
+	t.Run("ErrorNotFound", func(t *testing.T) {
+		comp := composite.NewComposite(
+			definer(data.Entry{}, dictionary.ErrorNotFound),
+		)
+
+		_, err := comp.Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+	t.Run("ErrorProviderUnavailable", func(t *testing.T) {
+		comp := composite.NewComposite(
+			definer(data.Entry{}, errors.New("unexpected error")),
+			definer(data.Entry{}, dictionary.ErrorNotFound),
+		)
+
+		_, err := comp.Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
+
+func TestCache_Define(t *testing.T) {
+	entry := data.Entry{Lemma: "word"}
+
+	t.Run("Exist", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("GetEntry", "id-id", "word").Return(entry, true, nil)
+
+		result, err := composite.NewCache(dataDictionary, "id-id").Define(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, entry, result)
+		}
+	})
+	t.Run("NotExist", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("GetEntry", "id-id", "word").Return(data.Entry{}, false, nil)
+
+		_, err := composite.NewCache(dataDictionary, "id-id").Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+	t.Run("ErrorUnreachable", func(t *testing.T) {
+		dataDictionary := &DataDictionary{}
+		dataDictionary.On("GetEntry", "id-id", "word").Return(data.Entry{}, false, errors.New("unexpected error"))
+
+		_, err := composite.NewCache(dataDictionary, "id-id").Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
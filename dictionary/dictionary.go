@@ -1,5 +1,74 @@
 package dictionary
 
+import (
+	"context"
+	"errors"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+var (
+	// ErrorNotFound is returned by a provider having no verdict for the lemma, e.g. a cache miss.
+	ErrorNotFound = errors.New("lemma verdict not found")
+	// ErrorProviderUnavailable is returned when the provider cannot be reached or answered unexpectedly.
+	ErrorProviderUnavailable = errors.New("dictionary provider is unavailable")
+	// ErrorRateLimited is returned when the provider refuses to answer more lookups for now.
+	ErrorRateLimited = errors.New("dictionary provider is rate limited")
+)
+
+// Dictionary validates lemma. Besides the errors above, the context error is returned
+// when the lookup is canceled.
 type Dictionary interface {
-	LemmaIsValid(string) (bool, error)
+	LemmaIsValid(ctx context.Context, lemma string) (bool, error)
+}
+
+// Definer is implemented by the providers knowing what a lemma means.
+// ErrorNotFound is returned when there is no entry of the lemma.
+type Definer interface {
+	Define(ctx context.Context, lemma string) (data.Entry, error)
+}
+
+// Verdict tells whether a lemma is valid and which provider decided it.
+type Verdict struct {
+	Valid  bool   `json:"valid"`
+	Source string `json:"source"`
+}
+
+// Arbiter is implemented by the dictionaries telling which provider decided, like composite.Composite.
+type Arbiter interface {
+	Verdict(ctx context.Context, lemma string) (Verdict, error)
+}
+
+// Batch is implemented by the dictionaries validating many lemmas at once, e.g. with one redis MGET
+// or one request. The verdicts are by lemma, missing the lemmas having no verdict like a cache miss.
+type Batch interface {
+	LemmasAreValid(ctx context.Context, lemmas []string) (map[string]Verdict, error)
+}
+
+// LemmasAreValid validates the lemmas at once when the dictionary is a Batch, one by one otherwise.
+// The lemmas having no verdict are missing from the verdicts.
+func LemmasAreValid(ctx context.Context, dict Dictionary, lemmas []string) (map[string]Verdict, error) {
+	if batch, ok := dict.(Batch); ok {
+		return batch.LemmasAreValid(ctx, lemmas)
+	}
+
+	arbiter, isArbiter := dict.(Arbiter)
+	verdicts := make(map[string]Verdict, len(lemmas))
+	for _, lemma := range lemmas {
+		var verdict Verdict
+		var err error
+		if isArbiter {
+			verdict, err = arbiter.Verdict(ctx, lemma)
+		} else {
+			verdict.Valid, err = dict.LemmaIsValid(ctx, lemma)
+		}
+		if err == ErrorNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		verdicts[lemma] = verdict
+	}
+	return verdicts, nil
 }
@@ -1 +1,71 @@
 package dictionary_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/dictionary"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+type lemmas map[string]error
+
+func (l lemmas) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	err, ok := l[lemma]
+	return ok && err == nil, err
+}
+
+type arbiter struct {
+	lemmas
+}
+
+func (a arbiter) Verdict(ctx context.Context, lemma string) (dictionary.Verdict, error) {
+	valid, err := a.LemmaIsValid(ctx, lemma)
+	return dictionary.Verdict{Valid: valid, Source: "arbiter"}, err
+}
+
+type batch struct {
+	lemmas
+}
+
+func (b batch) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	return map[string]dictionary.Verdict{"batch": {Valid: true, Source: "batch"}}, nil
+}
+
+func TestLemmasAreValid(t *testing.T) {
+	t.Run("OneByOne", func(t *testing.T) {
+		dict := lemmas{"makan": nil, "hilang": dictionary.ErrorNotFound}
+
+		verdicts, err := dictionary.LemmasAreValid(ctx, dict, []string{"makan", "hilang", "mkn"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{
+				"makan": {Valid: true},
+				"mkn":   {Valid: false},
+			}, verdicts, "the lemma not found is left out")
+		}
+	})
+	t.Run("Arbiter", func(t *testing.T) {
+		dict := arbiter{lemmas{"makan": nil}}
+
+		verdicts, err := dictionary.LemmasAreValid(ctx, dict, []string{"makan"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{"makan": {Valid: true, Source: "arbiter"}}, verdicts)
+		}
+	})
+	t.Run("Batch", func(t *testing.T) {
+		verdicts, err := dictionary.LemmasAreValid(ctx, batch{}, []string{"makan"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]dictionary.Verdict{"batch": {Valid: true, Source: "batch"}}, verdicts)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		dict := lemmas{"makan": nil, "hilang": errors.New("unexpected error")}
+
+		_, err := dictionary.LemmasAreValid(ctx, dict, []string{"makan", "hilang"})
+		assert.EqualError(t, err, "unexpected error")
+	})
+}
@@ -0,0 +1,100 @@
+package en_us
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+)
+
+// EnUs validates English lemma against a word list in the format of /usr/share/dict/words,
+// where proper nouns, possessives and abbreviations are mixed in with the playable words.
+type EnUs struct {
+	words *word_list.WordList
+}
+
+func NewEnUs(words *word_list.WordList) *EnUs {
+	return &EnUs{
+		words: words,
+	}
+}
+
+// Read loads one word per line, keeping only the words spelled with lowercase letters.
+func Read(reader io.Reader) (*EnUs, error) {
+	var words []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if playable(word) {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewEnUs(word_list.NewWordList(words)), nil
+}
+
+// Open loads the word list file on path.
+func Open(path string) (*EnUs, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return Read(file)
+}
+
+func (d *EnUs) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	if !playable(lemma) {
+		return false, nil
+	}
+	return d.words.LemmaIsValid(ctx, lemma)
+}
+
+func (d *EnUs) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	playables := make([]string, 0, len(lemmas))
+	for _, lemma := range lemmas {
+		if playable(lemma) {
+			playables = append(playables, lemma)
+		}
+	}
+	verdicts, err := d.words.LemmasAreValid(ctx, playables)
+	if err != nil {
+		return nil, err
+	}
+	for _, lemma := range lemmas {
+		if !playable(lemma) {
+			verdicts[lemma] = dictionary.Verdict{}
+		}
+	}
+	return verdicts, nil
+}
+
+// Words tells the words of the list, the unplayable ones included.
+func (d *EnUs) Words() []string {
+	return d.words.Words()
+}
+
+func (d *EnUs) Len() int {
+	return d.words.Len()
+}
+
+// playable rejects Paris, cat's and e.g., lemma are already lowercased by the game.
+func playable(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if r < 'a' || 'z' < r {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package en_us_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/en_us"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpen(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		enUs, err := en_us.Open("test/words.txt")
+		if assert.NoError(t, err) {
+			assert.Equal(t, 7, enUs.Len(), "proper nouns, possessives and abbreviations are skipped")
+		}
+	})
+	t.Run("ErrorFileNotExist", func(t *testing.T) {
+		_, err := en_us.Open("test/not_exist.txt")
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestEnUs_LemmaIsValid(t *testing.T) {
+	enUs, err := en_us.Open("test/words.txt")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	testCases := []struct {
+		lemma string
+		valid bool
+	}{
+		{"cat", true},
+		{"cats", true},
+		{"aardvark", true},
+		{"aaron", false},
+		{"paris", false},
+		{"aardvark's", false},
+		{"eg", false},
+		{"", false},
+		{"kucing", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lemma, func(t *testing.T) {
+			valid, err := enUs.LemmaIsValid(context.Background(), tc.lemma)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.valid, valid)
+			}
+		})
+	}
+}
+
+func TestEnUs_LemmasAreValid(t *testing.T) {
+	enUs, err := en_us.Open("test/words.txt")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	verdicts, err := enUs.LemmasAreValid(context.Background(), []string{"cats", "paris", "aardvark's", "kucing"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]dictionary.Verdict{
+			"cats":       {Valid: true},
+			"paris":      {Valid: false},
+			"aardvark's": {Valid: false},
+			"kucing":     {Valid: false},
+		}, verdicts)
+	}
+}
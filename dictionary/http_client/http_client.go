@@ -0,0 +1,323 @@
+package http_client
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	ErrorCircuitOpen = errors.New("dictionary provider is unhealthy, circuit is open")
+	ErrorUnavailable = errors.New("dictionary provider is unavailable")
+	ErrorRateLimited = errors.New("dictionary provider is rate limiting")
+)
+
+// metrics holds one map per client, published on /debug/vars
+var metrics = expvar.NewMap("dictionary_http_client")
+
+type CircuitState string
+
+const (
+	CLOSED    CircuitState = "closed"
+	OPEN      CircuitState = "open"
+	HALF_OPEN CircuitState = "half_open"
+)
+
+// Config tunes the client, zero values fall back to the defaults.
+type Config struct {
+	Timeout          time.Duration // per attempt
+	MaxRetries       int
+	BaseBackoff      time.Duration
+	MaxBackoff       time.Duration
+	FailureThreshold int // consecutive failures opening the circuit
+	OpenDuration     time.Duration
+
+	RequestsPerSecond float64 // outbound limit shared by every caller, zero is unlimited
+	Burst             int
+}
+
+var defaultConfig = Config{
+	Timeout:          5 * time.Second,
+	MaxRetries:       2,
+	BaseBackoff:      200 * time.Millisecond,
+	MaxBackoff:       2 * time.Second,
+	FailureThreshold: 5,
+	OpenDuration:     30 * time.Second,
+}
+
+func (c Config) withDefaults() Config {
+	if c.Timeout == 0 {
+		c.Timeout = defaultConfig.Timeout
+	}
+	if c.MaxRetries == 0 {
+		c.MaxRetries = defaultConfig.MaxRetries
+	}
+	if c.BaseBackoff == 0 {
+		c.BaseBackoff = defaultConfig.BaseBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = defaultConfig.MaxBackoff
+	}
+	if c.FailureThreshold == 0 {
+		c.FailureThreshold = defaultConfig.FailureThreshold
+	}
+	if c.OpenDuration == 0 {
+		c.OpenDuration = defaultConfig.OpenDuration
+	}
+	return c
+}
+
+// Client requests dictionary providers with per attempt timeout, retries with jittered backoff
+// on 5xx and 429, a circuit breaker failing fast while the provider is unhealthy,
+// and an optional token bucket limiting the outbound requests.
+// A 503 telling Retry-After is not retried, the requests fail fast until then, at most for OpenDuration.
+type Client struct {
+	httpClient *http.Client
+	config     Config
+	limiter    *limiter
+
+	mutex               sync.Mutex
+	state               CircuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	retryAt             time.Time
+
+	requests  *expvar.Int
+	retries   *expvar.Int
+	failures  *expvar.Int
+	rejected  *expvar.Int
+	throttled *expvar.Int
+}
+
+func NewClient(name string, httpClient *http.Client, config Config) *Client {
+	client := &Client{
+		httpClient: httpClient,
+		config:     config.withDefaults(),
+		state:      CLOSED,
+		requests:   new(expvar.Int),
+		retries:    new(expvar.Int),
+		failures:   new(expvar.Int),
+		rejected:   new(expvar.Int),
+		throttled:  new(expvar.Int),
+	}
+	if config.RequestsPerSecond > 0 {
+		client.limiter = newLimiter(config.RequestsPerSecond, config.Burst)
+	}
+
+	clientMetrics := new(expvar.Map).Init()
+	clientMetrics.Set("requests", client.requests)
+	clientMetrics.Set("retries", client.retries)
+	clientMetrics.Set("failures", client.failures)
+	clientMetrics.Set("rejected", client.rejected)
+	clientMetrics.Set("throttled", client.throttled)
+	clientMetrics.Set("circuit", expvar.Func(func() interface{} {
+		return client.State()
+	}))
+	metrics.Set(name, clientMetrics)
+
+	return client
+}
+
+// State of the circuit breaker.
+func (c *Client) State() CircuitState {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.state == OPEN && time.Since(c.openedAt) >= c.config.OpenDuration {
+		return HALF_OPEN
+	}
+	return c.state
+}
+
+// Get requests the url. Only a response having neither 5xx nor 429 status is returned,
+// its body must be closed by the caller.
+func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
+	if !c.allow() {
+		c.rejected.Add(1)
+		return nil, ErrorCircuitOpen
+	}
+
+	var err error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			c.retries.Add(1)
+			if err = sleep(ctx, c.backoff(attempt)); err != nil {
+				c.abandon()
+				return nil, err
+			}
+		}
+
+		var res *http.Response
+		var retryAfter time.Duration
+		res, retryAfter, err = c.do(ctx, url)
+		if err == nil {
+			c.succeed()
+			return res, nil
+		}
+		c.failures.Add(1)
+		if ctx.Err() != nil {
+			c.abandon()
+			return nil, ctx.Err()
+		}
+		if retryAfter > 0 {
+			c.holdOff(retryAfter)
+			break
+		}
+	}
+
+	c.fail()
+	return nil, err
+}
+
+// do requests the url once, telling how long to wait when the provider asked so on 503.
+func (c *Client) do(ctx context.Context, url string) (*http.Response, time.Duration, error) {
+	if c.limiter != nil {
+		waited, err := c.limiter.wait(ctx)
+		if waited {
+			c.throttled.Add(1)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	c.requests.Add(1)
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		cancel()
+		return nil, 0, err
+	}
+
+	res, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, 0, err
+	}
+	if res.StatusCode == http.StatusTooManyRequests {
+		_ = res.Body.Close()
+		cancel()
+		return nil, 0, ErrorRateLimited
+	}
+	if res.StatusCode >= 500 {
+		_ = res.Body.Close()
+		cancel()
+		var retryAfter time.Duration
+		if res.StatusCode == http.StatusServiceUnavailable {
+			retryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
+		}
+		return nil, retryAfter, ErrorUnavailable
+	}
+
+	// the timeout keeps covering the body until it is closed
+	res.Body = &cancelOnClose{res.Body, cancel}
+	return res, 0, nil
+}
+
+// parseRetryAfter reads either delay seconds or an HTTP date, zero when there is none.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.config.BaseBackoff << uint(attempt-1)
+	if backoff > c.config.MaxBackoff || backoff <= 0 {
+		backoff = c.config.MaxBackoff
+	}
+	// equal jitter, somewhere between half and the full backoff
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func (c *Client) allow() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if time.Now().Before(c.retryAt) {
+		return false
+	}
+	switch c.state {
+	case OPEN:
+		if time.Since(c.openedAt) < c.config.OpenDuration {
+			return false
+		}
+		// let one trial request through
+		c.state = HALF_OPEN
+		return true
+	case HALF_OPEN:
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *Client) succeed() {
+	c.mutex.Lock()
+	c.state = CLOSED
+	c.consecutiveFailures = 0
+	c.mutex.Unlock()
+}
+
+func (c *Client) fail() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.consecutiveFailures++
+	if c.state == HALF_OPEN || c.consecutiveFailures >= c.config.FailureThreshold {
+		c.state = OPEN
+		c.openedAt = time.Now()
+	}
+}
+
+// holdOff fails the requests fast until the provider asked to be retried.
+func (c *Client) holdOff(retryAfter time.Duration) {
+	if retryAfter > c.config.OpenDuration {
+		retryAfter = c.config.OpenDuration
+	}
+	c.mutex.Lock()
+	c.retryAt = time.Now().Add(retryAfter)
+	c.mutex.Unlock()
+}
+
+// abandon gives the trial back when the caller gave up, the provider health is still unknown.
+func (c *Client) abandon() {
+	c.mutex.Lock()
+	if c.state == HALF_OPEN {
+		c.state = OPEN
+	}
+	c.mutex.Unlock()
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,260 @@
+package http_client_test
+
+import (
+	"context"
+	"expvar"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/satriahrh/letter-block/dictionary/http_client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var config = http_client.Config{
+	Timeout:          50 * time.Millisecond,
+	MaxRetries:       2,
+	BaseBackoff:      time.Millisecond,
+	MaxBackoff:       2 * time.Millisecond,
+	FailureThreshold: 2,
+	OpenDuration:     20 * time.Millisecond,
+}
+
+// serve responds with the given statuses in order, repeating the last one
+func serve(statuses ...int) (server *httptest.Server, hits *int32) {
+	hits = new(int32)
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit := int(atomic.AddInt32(hits, 1))
+		status := statuses[len(statuses)-1]
+		if hit <= len(statuses) {
+			status = statuses[hit-1]
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("body"))
+	}))
+	return
+}
+
+func TestClient_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		server, hits := serve(http.StatusOK)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		res, err := client.Get(ctx, server.URL)
+		if assert.NoError(t, err) {
+			body, err := ioutil.ReadAll(res.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "body", string(body))
+			assert.NoError(t, res.Body.Close())
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+	})
+	t.Run("NotRetryingClientError", func(t *testing.T) {
+		server, hits := serve(http.StatusNotFound)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		res, err := client.Get(ctx, server.URL)
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusNotFound, res.StatusCode)
+			_ = res.Body.Close()
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+	})
+	t.Run("Retry", func(t *testing.T) {
+		testSuite := func(status int) func(t *testing.T) {
+			return func(t *testing.T) {
+				server, hits := serve(status, status, http.StatusOK)
+				defer server.Close()
+
+				client := http_client.NewClient(t.Name(), server.Client(), config)
+				res, err := client.Get(ctx, server.URL)
+				if assert.NoError(t, err) {
+					assert.Equal(t, http.StatusOK, res.StatusCode)
+					_ = res.Body.Close()
+				}
+				assert.Equal(t, int32(3), atomic.LoadInt32(hits))
+			}
+		}
+		t.Run("ServerError", testSuite(http.StatusBadGateway))
+		t.Run("TooManyRequests", testSuite(http.StatusTooManyRequests))
+	})
+	t.Run("ErrorUnavailable", func(t *testing.T) {
+		server, hits := serve(http.StatusInternalServerError)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		_, err := client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorUnavailable.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(hits))
+	})
+	t.Run("ErrorRetryAfter", func(t *testing.T) {
+		hits := new(int32)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(hits, 1) == 1 {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		_, err := client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorUnavailable.Error())
+		assert.Equal(t, int32(1), atomic.LoadInt32(hits), "not retried")
+
+		_, err = client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorCircuitOpen.Error(), "fail fast until retried")
+		assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+
+		time.Sleep(config.OpenDuration)
+		res, err := client.Get(ctx, server.URL)
+		if assert.NoError(t, err, "waiting no longer than the circuit would") {
+			_ = res.Body.Close()
+		}
+	})
+	t.Run("ErrorRateLimited", func(t *testing.T) {
+		server, hits := serve(http.StatusTooManyRequests)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		_, err := client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorRateLimited.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(hits))
+	})
+	t.Run("ErrorTimeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), http_client.Config{
+			Timeout: 10 * time.Millisecond, MaxRetries: 1, BaseBackoff: time.Millisecond,
+		})
+		start := time.Now()
+		_, err := client.Get(ctx, server.URL)
+		assert.Error(t, err)
+		assert.True(t, time.Since(start) < 500*time.Millisecond, "gave up on time")
+	})
+	t.Run("ErrorContextCanceled", func(t *testing.T) {
+		server, _ := serve(http.StatusServiceUnavailable)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), http_client.Config{
+			MaxRetries: 1, BaseBackoff: time.Second, MaxBackoff: time.Second,
+		})
+		canceledCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err := client.Get(canceledCtx, server.URL)
+		assert.EqualError(t, err, context.DeadlineExceeded.Error())
+	})
+	t.Run("RateLimit", func(t *testing.T) {
+		limited := http_client.Config{RequestsPerSecond: 20, Burst: 1}
+
+		t.Run("Waiting", func(t *testing.T) {
+			server, hits := serve(http.StatusOK)
+			defer server.Close()
+
+			client := http_client.NewClient(t.Name(), server.Client(), limited)
+			start := time.Now()
+			for i := 0; i < 3; i++ {
+				res, err := client.Get(ctx, server.URL)
+				if assert.NoError(t, err) {
+					_ = res.Body.Close()
+				}
+			}
+			assert.True(t, time.Since(start) >= 90*time.Millisecond, "two requests waited for a token")
+			assert.Equal(t, int32(3), atomic.LoadInt32(hits))
+		})
+		t.Run("ErrorContextCanceled", func(t *testing.T) {
+			server, hits := serve(http.StatusOK)
+			defer server.Close()
+
+			client := http_client.NewClient(t.Name(), server.Client(), http_client.Config{RequestsPerSecond: 0.1})
+			res, err := client.Get(ctx, server.URL)
+			if assert.NoError(t, err) {
+				_ = res.Body.Close()
+			}
+
+			canceledCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			_, err = client.Get(canceledCtx, server.URL)
+			assert.EqualError(t, err, context.DeadlineExceeded.Error())
+			assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+		})
+	})
+	t.Run("CircuitBreaker", func(t *testing.T) {
+		server, hits := serve(
+			http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+			http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+			http.StatusOK,
+		)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		for i := 0; i < config.FailureThreshold; i++ {
+			_, err := client.Get(ctx, server.URL)
+			assert.EqualError(t, err, http_client.ErrorUnavailable.Error())
+		}
+		assert.Equal(t, http_client.OPEN, client.State())
+
+		_, err := client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorCircuitOpen.Error(), "fail fast")
+		assert.Equal(t, int32(6), atomic.LoadInt32(hits))
+
+		time.Sleep(config.OpenDuration)
+		assert.Equal(t, http_client.HALF_OPEN, client.State())
+
+		res, err := client.Get(ctx, server.URL)
+		if assert.NoError(t, err) {
+			_ = res.Body.Close()
+		}
+		assert.Equal(t, http_client.CLOSED, client.State())
+	})
+	t.Run("CircuitReopenOnFailedTrial", func(t *testing.T) {
+		server, _ := serve(http.StatusInternalServerError)
+		defer server.Close()
+
+		client := http_client.NewClient(t.Name(), server.Client(), config)
+		for i := 0; i < config.FailureThreshold; i++ {
+			_, _ = client.Get(ctx, server.URL)
+		}
+		time.Sleep(config.OpenDuration)
+
+		_, err := client.Get(ctx, server.URL)
+		assert.EqualError(t, err, http_client.ErrorUnavailable.Error())
+		assert.Equal(t, http_client.OPEN, client.State())
+	})
+}
+
+func TestClient_Metrics(t *testing.T) {
+	server, _ := serve(http.StatusInternalServerError, http.StatusOK)
+	defer server.Close()
+
+	client := http_client.NewClient("metrics", server.Client(), config)
+	res, err := client.Get(context.Background(), server.URL)
+	if assert.NoError(t, err) {
+		_ = res.Body.Close()
+	}
+
+	metrics := expvar.Get("dictionary_http_client").(*expvar.Map).Get("metrics").(*expvar.Map)
+	assert.Equal(t, "2", metrics.Get("requests").String())
+	assert.Equal(t, "1", metrics.Get("retries").String())
+	assert.Equal(t, "1", metrics.Get("failures").String())
+	assert.Equal(t, "0", metrics.Get("rejected").String())
+	assert.Equal(t, "0", metrics.Get("throttled").String())
+	assert.Equal(t, `"closed"`, metrics.Get("circuit").String())
+}
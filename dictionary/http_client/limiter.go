@@ -0,0 +1,53 @@
+package http_client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket refilled at rate tokens per second up to burst tokens.
+type limiter struct {
+	rate   float64
+	burst  float64
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is taken, or the context is done. It tells whether it had to wait.
+func (l *limiter) wait(ctx context.Context) (waited bool, err error) {
+	for {
+		l.mutex.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mutex.Unlock()
+			return
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mutex.Unlock()
+
+		waited = true
+		if err = sleep(ctx, delay); err != nil {
+			return
+		}
+	}
+}
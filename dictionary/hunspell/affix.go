@@ -0,0 +1,200 @@
+package hunspell
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// affix holds what the .aff file tells, the rules and the flags of special meaning.
+type affix struct {
+	flagType      string // empty for single characters, long or num
+	needAffix     string
+	forbiddenWord string
+	prefixes      []*rule
+	suffixes      []*rule
+}
+
+type rule struct {
+	flag      string
+	suffix    bool
+	cross     bool // combinable with a rule of the other kind
+	strip     string
+	affix     string
+	condition []charClass
+}
+
+// charClass is one position of a rule condition, e.g. "a", "[aeiou]", "[^y]" or ".".
+type charClass struct {
+	chars   string
+	negated bool
+	any     bool
+}
+
+func readAffix(reader io.Reader) (*affix, error) {
+	a := &affix{}
+	// the headers, e.g. "SFX A Y 2", tell whether the following rules cross
+	cross := make(map[string]bool)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		switch fields[0] {
+		case "FLAG":
+			if len(fields) > 1 && fields[1] != "UTF-8" {
+				a.flagType = fields[1]
+			}
+		case "NEEDAFFIX":
+			if len(fields) > 1 {
+				a.needAffix = fields[1]
+			}
+		case "FORBIDDENWORD":
+			if len(fields) > 1 {
+				a.forbiddenWord = fields[1]
+			}
+		case "PFX", "SFX":
+			if len(fields) < 4 {
+				return nil, ErrorAffixInvalid
+			}
+			key := fields[0] + " " + fields[1]
+			if _, err := strconv.Atoi(fields[3]); err == nil && len(fields) == 4 {
+				cross[key] = fields[2] == "Y"
+				continue
+			}
+			if len(fields) < 5 {
+				// the condition defaults to any
+				fields = append(fields, ".")
+			}
+
+			r := &rule{
+				flag:   fields[1],
+				suffix: fields[0] == "SFX",
+				cross:  cross[key],
+				strip:  zero(fields[2]),
+				// continuation classes, e.g. "ing/S", are not followed
+				affix: zero(strings.SplitN(fields[3], "/", 2)[0]),
+			}
+			condition, ok := parseCondition(fields[4])
+			if !ok {
+				return nil, ErrorAffixInvalid
+			}
+			r.condition = condition
+			if r.suffix {
+				a.suffixes = append(a.suffixes, r)
+			} else {
+				a.prefixes = append(a.prefixes, r)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *affix) parseFlags(raw string) (flags []string) {
+	switch a.flagType {
+	case "long":
+		characters := []rune(raw)
+		for i := 0; i+1 < len(characters); i += 2 {
+			flags = append(flags, string(characters[i:i+2]))
+		}
+	case "num":
+		for _, flag := range strings.Split(raw, ",") {
+			if flag != "" {
+				flags = append(flags, flag)
+			}
+		}
+	default:
+		for _, character := range raw {
+			flags = append(flags, string(character))
+		}
+	}
+	return
+}
+
+// remove takes the affix off the word and puts back the stripped characters,
+// as long as the stem satisfies the rule condition.
+func (r *rule) remove(word string) (string, bool) {
+	var root string
+	if r.suffix {
+		if !strings.HasSuffix(word, r.affix) || len(word) == len(r.affix) {
+			return "", false
+		}
+		root = strings.TrimSuffix(word, r.affix) + r.strip
+	} else {
+		if !strings.HasPrefix(word, r.affix) || len(word) == len(r.affix) {
+			return "", false
+		}
+		root = r.strip + strings.TrimPrefix(word, r.affix)
+	}
+	return root, r.matches(root)
+}
+
+// matches checks the condition on the end of the root for a suffix, on its start for a prefix.
+func (r *rule) matches(root string) bool {
+	characters := []rune(root)
+	if len(characters) < len(r.condition) {
+		return false
+	}
+	offset := 0
+	if r.suffix {
+		offset = len(characters) - len(r.condition)
+	}
+	for i, class := range r.condition {
+		if !class.matches(characters[offset+i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c charClass) matches(character rune) bool {
+	if c.any {
+		return true
+	}
+	return strings.ContainsRune(c.chars, character) != c.negated
+}
+
+func parseCondition(raw string) (condition []charClass, ok bool) {
+	if raw == "." {
+		return nil, true
+	}
+	characters := []rune(raw)
+	for i := 0; i < len(characters); i++ {
+		switch characters[i] {
+		case '.':
+			condition = append(condition, charClass{any: true})
+		case '[':
+			end := i + 1
+			for end < len(characters) && characters[end] != ']' {
+				end++
+			}
+			if end == len(characters) {
+				return nil, false
+			}
+			class := charClass{chars: string(characters[i+1 : end])}
+			if strings.HasPrefix(class.chars, "^") {
+				class.negated = true
+				class.chars = class.chars[1:]
+			}
+			condition = append(condition, class)
+			i = end
+		default:
+			condition = append(condition, charClass{chars: string(characters[i])})
+		}
+	}
+	return condition, true
+}
+
+// zero is the empty strip or affix of the rule
+func zero(raw string) string {
+	if raw == "0" {
+		return ""
+	}
+	return raw
+}
@@ -0,0 +1,167 @@
+package hunspell
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorAffixInvalid = errors.New("hunspell affix file invalid")
+)
+
+// Hunspell validates lemma against a Hunspell dictionary, expanding the prefix and suffix rules
+// of the .aff file on the stems of the .dic file. Continuation classes, compounding and
+// morphological fields are not supported, they are ignored.
+type Hunspell struct {
+	affix *affix
+	words map[string]*stem
+}
+
+type stem struct {
+	flags      map[string]bool
+	standalone bool // a homonym without NEEDAFFIX
+	forbidden  bool
+}
+
+// Open loads the dictionary from the .dic and .aff file.
+func Open(dicPath, affPath string) (*Hunspell, error) {
+	aff, err := os.Open(affPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = aff.Close()
+	}()
+	dic, err := os.Open(dicPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = dic.Close()
+	}()
+	return Read(dic, aff)
+}
+
+// Read loads the dictionary from the content of the .dic and .aff file.
+func Read(dic, aff io.Reader) (*Hunspell, error) {
+	affix, err := readAffix(aff)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Hunspell{
+		affix: affix,
+		words: make(map[string]*stem),
+	}
+	scanner := bufio.NewScanner(dic)
+	first := true
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if first {
+			first = false
+			// the first line tells the approximate number of words
+			if _, err := strconv.Atoi(line); err == nil {
+				continue
+			}
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// morphological fields follow the word
+		line = strings.Fields(line)[0]
+
+		word, rawFlags := line, ""
+		if i := strings.Index(line, "/"); i > 0 {
+			word, rawFlags = line[:i], line[i+1:]
+		}
+		h.add(word, affix.parseFlags(rawFlags))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *Hunspell) add(word string, flags []string) {
+	s, ok := h.words[word]
+	if !ok {
+		s = &stem{flags: make(map[string]bool)}
+		h.words[word] = s
+	}
+	needAffix := false
+	for _, flag := range flags {
+		s.flags[flag] = true
+		switch flag {
+		case h.affix.needAffix:
+			needAffix = true
+		case h.affix.forbiddenWord:
+			s.forbidden = true
+		}
+	}
+	if !needAffix {
+		s.standalone = true
+	}
+}
+
+// LemmaIsValid accepts a word of the dictionary, or one made of a stem with a prefix, a suffix or both
+// allowed by the stem flags. The lemma is checked as is, as lowercase words do not match proper nouns.
+func (h *Hunspell) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	if s, ok := h.words[lemma]; ok {
+		if s.forbidden {
+			return false, nil
+		}
+		if s.standalone {
+			return true, nil
+		}
+	}
+
+	for _, suffix := range h.affix.suffixes {
+		if root, ok := suffix.remove(lemma); ok && h.hasFlags(root, suffix.flag) {
+			return true, nil
+		}
+	}
+	for _, prefix := range h.affix.prefixes {
+		root, ok := prefix.remove(lemma)
+		if !ok {
+			continue
+		}
+		if h.hasFlags(root, prefix.flag) {
+			return true, nil
+		}
+		if !prefix.cross {
+			continue
+		}
+		for _, suffix := range h.affix.suffixes {
+			if !suffix.cross {
+				continue
+			}
+			if crossRoot, ok := suffix.remove(root); ok && h.hasFlags(crossRoot, prefix.flag, suffix.flag) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Len tells the number of stems.
+func (h *Hunspell) Len() int {
+	return len(h.words)
+}
+
+func (h *Hunspell) hasFlags(word string, flags ...string) bool {
+	s, ok := h.words[word]
+	if !ok || s.forbidden {
+		return false
+	}
+	for _, flag := range flags {
+		if !s.flags[flag] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package hunspell_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/satriahrh/letter-block/dictionary/hunspell"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+func TestOpen(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		h, err := hunspell.Open("test/test.dic", "test/test.aff")
+		if assert.NoError(t, err) {
+			assert.Equal(t, 9, h.Len())
+		}
+	})
+	t.Run("ErrorFileNotExist", func(t *testing.T) {
+		_, err := hunspell.Open("test/test.dic", "test/not_exist.aff")
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("ErrorAffixInvalid", func(t *testing.T) {
+		_, err := hunspell.Read(strings.NewReader("cat/S"), strings.NewReader("SFX S Y 1\nSFX S 0 s [^y\n"))
+		assert.EqualError(t, err, hunspell.ErrorAffixInvalid.Error())
+	})
+}
+
+func TestHunspell_LemmaIsValid(t *testing.T) {
+	h, err := hunspell.Open("test/test.dic", "test/test.aff")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	testCases := []struct {
+		lemma string
+		valid bool
+	}{
+		{"cat", true},
+		{"cats", true},
+		{"cities", true},
+		{"citys", false},
+		{"boxes", true},
+		{"boxs", false},
+		{"baked", true},
+		{"baking", true},
+		{"bakeing", false},
+		{"played", true},
+		{"plays", true},
+		{"unplayed", true}, // cross product
+		{"replay", false},  // no R flag
+		{"redo", true},
+		{"untied", true},
+		{"tied", true},
+		{"colour", false}, // needs an affix
+		{"colours", true},
+		{"paris", false},
+		{"Paris", true},
+		{"dog", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lemma, func(t *testing.T) {
+			valid, err := h.LemmaIsValid(ctx, tc.lemma)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.valid, valid)
+			}
+		})
+	}
+
+	t.Run("ForbiddenWord", func(t *testing.T) {
+		h, err := hunspell.Read(
+			strings.NewReader("2\ncat/S\ncats/*\n"),
+			strings.NewReader("FORBIDDENWORD *\nSFX S Y 1\nSFX S 0 s .\n"),
+		)
+		if assert.NoError(t, err) {
+			valid, _ := h.LemmaIsValid(ctx, "cats")
+			assert.False(t, valid)
+		}
+	})
+	t.Run("LongFlags", func(t *testing.T) {
+		h, err := hunspell.Read(
+			strings.NewReader("1\nkat/AaBb\n"),
+			strings.NewReader("FLAG long\nSFX Bb Y 1\nSFX Bb 0 ten .\n"),
+		)
+		if assert.NoError(t, err) {
+			valid, _ := h.LemmaIsValid(ctx, "katten")
+			assert.True(t, valid)
+		}
+	})
+}
@@ -1,73 +1,186 @@
 package id_id
 
 import (
+	"context"
 	"log"
 
 	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
 
-	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
-var (
-	ErrorHttpUnexpected = errors.New("unexpected http error")
-)
-
 const (
 	baseUrl  = "https://kbbi.kemdikbud.go.id/entri"
 	language = "id-id"
+	source   = "kbbi"
 )
 
+// HttpClient is satisfied by http_client.Client
+type HttpClient interface {
+	Get(ctx context.Context, url string) (*http.Response, error)
+}
+
 type IdId struct {
 	cache      data.Dictionary
-	httpClient *http.Client
+	httpClient HttpClient
+	policy     Policy
+	language   string // the cache language, verdicts differ by policy
+	group      group
 }
 
-func NewIdId(dictionary data.Dictionary, httpClient *http.Client) *IdId {
+func NewIdId(dictionary data.Dictionary, httpClient HttpClient, policy Policy) *IdId {
 	return &IdId{
 		cache:      dictionary,
 		httpClient: httpClient,
+		policy:     policy,
+		language:   CacheLanguage(policy),
 	}
 }
 
-func (d *IdId) LemmaIsValid(lemma string) (result bool, err error) {
+func (d *IdId) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
 	// Exist On Cache?
-	var exist bool
-	result, exist = d.cache.Get(language, lemma)
-	if exist {
+	result, exist, err := d.cache.Get(ctx, d.language, lemma)
+	if err != nil {
+		// the cache is only an optimization, ask KBBI instead
+		log.Printf("dictionary cache failed on %v: %v", lemma, err)
+	} else if exist {
 		return result, nil
 	}
 
+	return d.lookup(ctx, lemma)
+}
+
+// Online looks up KBBI without reading the cache first, for a composite asking its cache provider before.
+// The verdicts are still cached.
+func (d *IdId) Online() dictionary.Dictionary {
+	return online{idId: d}
+}
+
+type online struct {
+	idId *IdId
+}
+
+func (o online) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	return o.idId.lookup(ctx, lemma)
+}
+
+// Define tells the entry of the lemma as written on KBBI, as kept on the cache when its verdict was looked up.
+// It never asks KBBI itself, as words are defined in bulk, e.g. every played word of a game.
+func (d *IdId) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	entry, exist, err := d.cache.GetEntry(ctx, d.language, lemma)
+	if err != nil {
+		log.Printf("dictionary cache failed on %v: %v", lemma, err)
+		return data.Entry{}, dictionary.ErrorProviderUnavailable
+	}
+	if !exist {
+		return data.Entry{}, dictionary.ErrorNotFound
+	}
+	return entry, nil
+}
+
+func (d *IdId) lookup(ctx context.Context, lemma string) (bool, error) {
+	// Identical concurrent lookups share one request
+	return d.group.do(ctx, lemma, func(ctx context.Context) (bool, error) {
+		return d.request(ctx, lemma)
+	})
+}
+
+func (d *IdId) request(ctx context.Context, lemma string) (result bool, err error) {
 	// Request To KBBI
 	url := fmt.Sprintf("%v/%v", baseUrl, lemma)
 	log.Println(url)
-	res, err := d.httpClient.Get(url)
+	res, err := d.httpClient.Get(ctx, url)
 	if err != nil {
-		return
-	}
-	if res.StatusCode != 200 {
-		err = ErrorHttpUnexpected
-		return
+		return result, providerError(ctx, err)
 	}
-
 	defer func() {
 		_ = res.Body.Close()
 	}()
+	if res.StatusCode != 200 {
+		log.Printf("unexpected KBBI response status %v on %v", res.StatusCode, lemma)
+		return result, dictionary.ErrorProviderUnavailable
+	}
+
 	// Load the HTML document
 	doc, err := goquery.NewDocumentFromReader(res.Body)
 	if err != nil {
-		return
+		return result, providerError(ctx, err)
 	}
 
-	// Find the lemma
+	// Find the lemma, KBBI echoes it back in the h2 when there is no entry
+	found := false
 	doc.Find("h2").First().Each(func(i int, s *goquery.Selection) {
 		flag := s.Text()
-		result = flag != lemma
+		found = flag != lemma
 	})
+	result = found && (d.policy == AFFIXED || parseRoot(doc) == strings.ToLower(lemma))
+
+	if result {
+		// kept for Define, which never asks KBBI
+		if err := d.cache.SetEntry(ctx, d.language, lemma, parseEntry(doc)); err != nil {
+			log.Printf("dictionary cache failed on %v: %v", lemma, err)
+		}
+	}
+	if err := d.cache.Set(ctx, d.language, lemma, result, source); err != nil {
+		log.Printf("dictionary cache failed on %v: %v", lemma, err)
+	}
+	return
+}
+
+// parseHeadword reads the first h2 without its homonym number and root link, e.g. "me.ma.kan".
+func parseHeadword(doc *goquery.Document) string {
+	heading := doc.Find("h2").First().Clone()
+	heading.Find("sup, span.rootword").Remove()
+	return strings.TrimSpace(heading.Text())
+}
+
+// parseRoot tells the root word of the entry. An affixed entry links its root, e.g. memakan to makan,
+// while a root entry is its own root once the syllable dots are gone.
+func parseRoot(doc *goquery.Document) string {
+	root := strings.TrimSpace(doc.Find("h2").First().Find("span.rootword a").First().Text())
+	if root == "" {
+		root = parseHeadword(doc)
+	}
+	return strings.ToLower(strings.Replace(root, ".", "", -1))
+}
+
+// parseEntry reads the lemma from the first h2, and the definitions of every homonym listed under the h2s.
+func parseEntry(doc *goquery.Document) (entry data.Entry) {
+	entry.Lemma = parseHeadword(doc)
+
+	entry.Definitions = []string{}
+	doc.Find("ul.adjusted-par > li, ol > li").Each(func(i int, s *goquery.Selection) {
+		if entry.Class == "" {
+			// e.g. "Nomina: kata benda"
+			if title, ok := s.Find("i span[title]").First().Attr("title"); ok {
+				entry.Class = strings.TrimSpace(strings.SplitN(title, ":", 2)[0])
+			}
+		}
 
-	d.cache.Set(language, lemma, result)
+		definition := s.Clone()
+		definition.Find("font").Remove()
+		text := strings.Join(strings.Fields(definition.Text()), " ")
+		if text != "" {
+			entry.Definitions = append(entry.Definitions, text)
+		}
+	})
 	return
 }
+
+// providerError tells the caller why KBBI did not answer, using the dictionary errors.
+func providerError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == http_client.ErrorRateLimited {
+		return dictionary.ErrorRateLimited
+	}
+	log.Println(err)
+	return dictionary.ErrorProviderUnavailable
+}
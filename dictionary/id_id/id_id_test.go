@@ -1,9 +1,13 @@
 package id_id_test
 
 import (
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
 	"github.com/satriahrh/letter-block/dictionary/id_id"
 
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -11,7 +15,10 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,13 +28,28 @@ type DataDictionary struct {
 	mock.Mock
 }
 
-func (d *DataDictionary) Get(lang, key string) (bool, bool) {
+func (d *DataDictionary) Get(ctx context.Context, lang, key string) (bool, bool, error) {
 	args := d.Called(lang, key)
-	return args.Bool(0), args.Bool(1)
+	return args.Bool(0), args.Bool(1), args.Error(2)
 }
 
-func (d *DataDictionary) Set(lang, key string, value bool) {
-	d.Called(lang, key, value)
+func (d *DataDictionary) GetMany(ctx context.Context, lang string, keys []string) (map[string]bool, error) {
+	args := d.Called(lang, keys)
+	results, _ := args.Get(0).(map[string]bool)
+	return results, args.Error(1)
+}
+
+func (d *DataDictionary) Set(ctx context.Context, lang, key string, value bool, source string) error {
+	return d.Called(lang, key, value, source).Error(0)
+}
+
+func (d *DataDictionary) GetEntry(ctx context.Context, lang, key string) (data.Entry, bool, error) {
+	args := d.Called(lang, key)
+	return args.Get(0).(data.Entry), args.Bool(1), args.Error(2)
+}
+
+func (d *DataDictionary) SetEntry(ctx context.Context, lang, key string, entry data.Entry) error {
+	return d.Called(lang, key, entry).Error(0)
 }
 
 type RoundTripFunc func(req *http.Request) *http.Response
@@ -42,6 +64,14 @@ func (f RoundTripErrorFunc) RoundTrip(req *http.Request) (*http.Response, error)
 	return nil, errors.New("unexpected error")
 }
 
+type RoundTripContextFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripContextFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var ctx = context.Background()
+
 func TestIdId_LemmaIsValid(t *testing.T) {
 	language := "id-id"
 	lemma := "word"
@@ -50,7 +80,10 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 		dataDictionary = &DataDictionary{}
 		idId = id_id.NewIdId(
 			dataDictionary,
-			httpClient,
+			http_client.NewClient("id_id_test", httpClient, http_client.Config{
+				BaseBackoff: time.Millisecond,
+			}),
+			id_id.AFFIXED,
 		)
 		return
 	}
@@ -61,9 +94,9 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 			dataDictionary.
 				On("Get", "id-id", "word").
-				Return(true, true)
+				Return(true, true, nil)
 
-			result, err := idId.LemmaIsValid(lemma)
+			result, err := idId.LemmaIsValid(ctx, lemma)
 			if !assert.NoError(t, err, "using cache") {
 				t.FailNow()
 			}
@@ -76,9 +109,9 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 			dataDictionary.
 				On("Get", language, lemma).
-				Return(false, true)
+				Return(false, true, nil)
 
-			result, err := idId.LemmaIsValid(lemma)
+			result, err := idId.LemmaIsValid(ctx, lemma)
 			if !assert.NoError(t, err, "using cache") {
 				t.FailNow()
 			}
@@ -98,12 +131,31 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 			dataDictionary.
 				On("Get", "id-id", "word").
-				Return(false, false)
+				Return(false, false, nil)
 
-			_, err := idId.LemmaIsValid(lemma)
-			assert.Regexp(t, "unexpected error", err.Error(), "unexpected error")
+			_, err := idId.LemmaIsValid(ctx, lemma)
+			assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
 		})
 		t.Run("GotNon200Response", func(t *testing.T) {
+			client := &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: 403,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`forbidden`)),
+					}
+				}),
+			}
+
+			dataDictionary, idId := testSuite(client)
+
+			dataDictionary.
+				On("Get", "id-id", "word").
+				Return(false, false, nil)
+
+			_, err := idId.LemmaIsValid(ctx, lemma)
+			assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error(), "403 error")
+		})
+		t.Run("GotServerError", func(t *testing.T) {
 			client := &http.Client{
 				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
 					return &http.Response{
@@ -117,10 +169,47 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 			dataDictionary.
 				On("Get", "id-id", "word").
-				Return(false, false)
+				Return(false, false, nil)
+
+			_, err := idId.LemmaIsValid(ctx, lemma)
+			assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error(), "retried then gave up")
+		})
+		t.Run("GotTooManyRequests", func(t *testing.T) {
+			client := &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: 429,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`too many requests`)),
+					}
+				}),
+			}
+
+			dataDictionary, idId := testSuite(client)
+
+			dataDictionary.
+				On("Get", "id-id", "word").
+				Return(false, false, nil)
 
-			_, err := idId.LemmaIsValid(lemma)
-			assert.EqualError(t, err, id_id.ErrorHttpUnexpected.Error(), "500 error")
+			_, err := idId.LemmaIsValid(ctx, lemma)
+			assert.EqualError(t, err, dictionary.ErrorRateLimited.Error())
+		})
+		t.Run("ContextCanceled", func(t *testing.T) {
+			client := &http.Client{
+				Transport: RoundTripErrorFunc(func(req *http.Request) *http.Response {
+					return nil
+				}),
+			}
+
+			dataDictionary, idId := testSuite(client)
+
+			dataDictionary.
+				On("Get", "id-id", "word").
+				Return(false, false, nil)
+
+			canceledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := idId.LemmaIsValid(canceledCtx, lemma)
+			assert.EqualError(t, err, context.Canceled.Error())
 		})
 	})
 	t.Run("ErrorLoadingHtmlDocument", func(t *testing.T) {
@@ -138,10 +227,38 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 		dataDictionary.
 			On("Get", "id-id", "word").
-			Return(false, false)
+			Return(false, false, nil)
+
+		_, err := idId.LemmaIsValid(ctx, lemma)
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error(), "unreadable body")
+	})
+	t.Run("CacheUnreachable", func(t *testing.T) {
+		client := &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				file, _ := os.Open("test/example_found.html")
+				return &http.Response{
+					StatusCode: 200,
+					Body:       file,
+				}
+			}),
+		}
+
+		dataDictionary, idId := testSuite(client)
+
+		dataDictionary.
+			On("Get", "id-id", "word").
+			Return(false, false, errors.New("unexpected error"))
+		dataDictionary.
+			On("Set", "id-id", "word", true, "kbbi").
+			Return(errors.New("unexpected error"))
+		dataDictionary.
+			On("SetEntry", "id-id", "word", mock.Anything).
+			Return(errors.New("unexpected error"))
 
-		_, err := idId.LemmaIsValid(lemma)
-		assert.EqualError(t, err, os.ErrInvalid.Error(), "500 error")
+		result, err := idId.LemmaIsValid(ctx, lemma)
+		if assert.NoError(t, err, "asking KBBI instead") {
+			assert.True(t, result)
+		}
 	})
 	t.Run("Verdict", func(t *testing.T) {
 		testSuiteVerdict := func(t *testing.T) {
@@ -167,13 +284,16 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 
 			dataDictionary.
 				On("Get", "id-id", "word").
-				Return(false, false)
+				Return(false, false, nil)
 
 			dataDictionary.
-				On("Set", "id-id", "word", fileName == "found").
-				Return().Once()
+				On("Set", "id-id", "word", fileName == "found", "kbbi").
+				Return(nil).Once()
+			dataDictionary.
+				On("SetEntry", "id-id", "word", mock.Anything).
+				Return(nil)
 
-			result, err := idId.LemmaIsValid(lemma)
+			result, err := idId.LemmaIsValid(ctx, lemma)
 			if assert.NoError(t, err, "should return verdict") {
 				if fileName == "found" {
 					assert.True(t, result)
@@ -187,5 +307,261 @@ func TestIdId_LemmaIsValid(t *testing.T) {
 		t.Run("Found", testSuiteVerdict)
 		t.Run("NotFound", testSuiteVerdict)
 	})
+	t.Run("OnlineSkipsTheCache", func(t *testing.T) {
+		client := &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				file, _ := os.Open("test/example_found.html")
+				return &http.Response{
+					StatusCode: 200,
+					Body:       file,
+				}
+			}),
+		}
+
+		dataDictionary, idId := testSuite(client)
+
+		dataDictionary.
+			On("Set", "id-id", "word", true, "kbbi").
+			Return(nil).Once()
+		dataDictionary.
+			On("SetEntry", "id-id", "word", mock.Anything).
+			Return(nil)
+
+		result, err := idId.Online().LemmaIsValid(ctx, lemma)
+		if assert.NoError(t, err) {
+			assert.True(t, result)
+			dataDictionary.AssertNotCalled(t, "Get", "id-id", "word")
+		}
+	})
+	t.Run("CoalescingConcurrentLookups", func(t *testing.T) {
+		release := make(chan struct{})
+		var hits int32
+		client := &http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+				atomic.AddInt32(&hits, 1)
+				<-release
+				file, _ := os.Open("test/example_found.html")
+				return &http.Response{
+					StatusCode: 200,
+					Body:       file,
+				}
+			}),
+		}
+
+		dataDictionary, idId := testSuite(client)
+
+		dataDictionary.
+			On("Get", "id-id", "word").
+			Return(false, false, nil)
+
+		dataDictionary.
+			On("Set", "id-id", "word", true, "kbbi").
+			Return(nil).Once()
+		dataDictionary.
+			On("SetEntry", "id-id", "word", mock.Anything).
+			Return(nil)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, err := idId.LemmaIsValid(ctx, lemma)
+				assert.NoError(t, err)
+				assert.True(t, result)
+			}()
+		}
+		for atomic.LoadInt32(&hits) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+		dataDictionary.AssertNumberOfCalls(t, "Set", 1)
+	})
+	t.Run("CoalescedLookupOfCanceledCaller", func(t *testing.T) {
+		var hits int32
+		client := &http.Client{
+			Transport: RoundTripContextFunc(func(req *http.Request) (*http.Response, error) {
+				if atomic.AddInt32(&hits, 1) == 1 {
+					// the first caller gives up while waiting
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				}
+				file, _ := os.Open("test/example_found.html")
+				return &http.Response{
+					StatusCode: 200,
+					Body:       file,
+				}, nil
+			}),
+		}
 
+		dataDictionary, idId := testSuite(client)
+
+		dataDictionary.
+			On("Get", "id-id", "word").
+			Return(false, false, nil)
+		dataDictionary.
+			On("Set", "id-id", "word", true, "kbbi").
+			Return(nil)
+		dataDictionary.
+			On("SetEntry", "id-id", "word", mock.Anything).
+			Return(nil)
+
+		firstCtx, cancel := context.WithCancel(ctx)
+		firstErr := make(chan error)
+		go func() {
+			_, err := idId.LemmaIsValid(firstCtx, lemma)
+			firstErr <- err
+		}()
+		for atomic.LoadInt32(&hits) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		followed := make(chan bool)
+		go func() {
+			result, err := idId.LemmaIsValid(ctx, lemma)
+			assert.NoError(t, err)
+			followed <- result
+		}()
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+
+		assert.Equal(t, context.Canceled, <-firstErr)
+		assert.True(t, <-followed, "the verdict despite the first caller giving up")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	})
+}
+
+func TestIdId_Define(t *testing.T) {
+	entry := data.Entry{
+		Lemma: "AB",
+		Class: "Nomina",
+		Definitions: []string{
+			"anggaran belanja", "wadah kecil dari timah untuk candu; hap", "arah benar",
+			"ayah", "Angkatan Bersenjata", "administrasi bisnis",
+		},
+	}
+	testSuite := func(page string) (dataDictionary *DataDictionary, idId *id_id.IdId) {
+		dataDictionary = &DataDictionary{}
+		idId = id_id.NewIdId(
+			dataDictionary,
+			http_client.NewClient("id_id_test", &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					file, _ := os.Open(page)
+					return &http.Response{
+						StatusCode: 200,
+						Body:       file,
+					}
+				}),
+			}, http_client.Config{}),
+			id_id.AFFIXED,
+		)
+		return
+	}
+
+	t.Run("ExistOnCache", func(t *testing.T) {
+		dataDictionary, idId := testSuite("")
+		dataDictionary.
+			On("GetEntry", "id-id", "word").
+			Return(entry, true, nil)
+
+		result, err := idId.Define(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, entry, result)
+		}
+	})
+	t.Run("ErrorProviderUnavailable", func(t *testing.T) {
+		dataDictionary, idId := testSuite("")
+		dataDictionary.
+			On("GetEntry", "id-id", "word").
+			Return(data.Entry{}, false, errors.New("unexpected error"))
+
+		_, err := idId.Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+	t.Run("NotOnCache", func(t *testing.T) {
+		dataDictionary, idId := testSuite("test/example_found.html")
+		dataDictionary.
+			On("GetEntry", "id-id", "word").
+			Return(data.Entry{}, false, nil)
+
+		_, err := idId.Define(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+		dataDictionary.AssertNotCalled(t, "Set", "id-id", "word", mock.Anything, mock.Anything)
+	})
+	t.Run("CachedOnLookup", func(t *testing.T) {
+		dataDictionary, idId := testSuite("test/example_found.html")
+		dataDictionary.
+			On("Get", "id-id", "word").
+			Return(false, false, nil)
+		dataDictionary.
+			On("Set", "id-id", "word", true, "kbbi").
+			Return(nil)
+		dataDictionary.
+			On("SetEntry", "id-id", "word", entry).
+			Return(nil).Once()
+
+		valid, err := idId.LemmaIsValid(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.True(t, valid)
+		}
+		dataDictionary.AssertExpectations(t)
+	})
+}
+
+func TestIdId_Policy(t *testing.T) {
+	testSuite := func(page string, policy id_id.Policy) (dataDictionary *DataDictionary, idId *id_id.IdId) {
+		dataDictionary = &DataDictionary{}
+		idId = id_id.NewIdId(
+			dataDictionary,
+			http_client.NewClient("id_id_test", &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					file, _ := os.Open(page)
+					return &http.Response{
+						StatusCode: 200,
+						Body:       file,
+					}
+				}),
+			}, http_client.Config{}),
+			policy,
+		)
+		return
+	}
+
+	testCases := []struct {
+		name     string
+		page     string
+		lemma    string
+		policy   id_id.Policy
+		language string
+		valid    bool
+	}{
+		{"AffixedAcceptsDerived", "test/example_derived.html", "memakan", id_id.AFFIXED, "id-id", true},
+		{"RootOnlyRejectsDerived", "test/example_derived.html", "memakan", id_id.ROOT_ONLY, "id-id.root", false},
+		{"RootOnlyAcceptsRoot", "test/example_found.html", "ab", id_id.ROOT_ONLY, "id-id.root", true},
+		{"RootOnlyRejectsNotFound", "test/example_not_found.html", "word", id_id.ROOT_ONLY, "id-id.root", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dataDictionary, idId := testSuite(tc.page, tc.policy)
+			dataDictionary.
+				On("Get", tc.language, tc.lemma).
+				Return(false, false, nil)
+			dataDictionary.
+				On("SetEntry", tc.language, tc.lemma, mock.Anything).
+				Return(nil)
+			dataDictionary.
+				On("Set", tc.language, tc.lemma, tc.valid, "kbbi").
+				Return(nil).Once()
+
+			result, err := idId.LemmaIsValid(ctx, tc.lemma)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.valid, result)
+			}
+			dataDictionary.AssertCalled(t, "Set", tc.language, tc.lemma, tc.valid, "kbbi")
+		})
+	}
 }
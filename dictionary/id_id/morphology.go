@@ -0,0 +1,86 @@
+package id_id
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+var (
+	ErrorUnknownPolicy = errors.New("unknown morphology policy")
+)
+
+// Policy decides which Indonesian word forms are playable.
+type Policy uint8
+
+const (
+	AFFIXED   Policy = iota // roots and their affixed forms listed in the dictionary
+	ROOT_ONLY Policy = iota // root words only, e.g. makan but not memakan nor makanan
+)
+
+func ParsePolicy(raw string) (Policy, error) {
+	switch strings.ToUpper(strings.TrimSpace(raw)) {
+	case "", "AFFIXED":
+		return AFFIXED, nil
+	case "ROOT_ONLY":
+		return ROOT_ONLY, nil
+	}
+	return AFFIXED, ErrorUnknownPolicy
+}
+
+// CacheLanguage keys the cached verdicts, as they differ by policy.
+func CacheLanguage(policy Policy) string {
+	if policy == ROOT_ONLY {
+		return language + ".root"
+	}
+	return language
+}
+
+// CacheLanguages tells every cache language of the dictionary language, one per policy on id-id,
+// so a verdict changed by hand is changed whichever policy is served.
+func CacheLanguages(dictionaryLanguage string) []string {
+	if dictionaryLanguage != language {
+		return []string{dictionaryLanguage}
+	}
+	return []string{CacheLanguage(AFFIXED), CacheLanguage(ROOT_ONLY)}
+}
+
+// Local enforces the policy on a local word list. The list cannot tell how a word is formed,
+// so the stemmer only points out the listed words that might be affixed. On ROOT_ONLY it is best effort,
+// unlike KBBI telling the root of an entry: a listed affixed word whose root is not listed, like pertanian
+// without tani, is taken as a root.
+type Local struct {
+	words  dictionary.Dictionary
+	policy Policy
+}
+
+func NewLocal(words dictionary.Dictionary, policy Policy) *Local {
+	return &Local{
+		words:  words,
+		policy: policy,
+	}
+}
+
+// LemmaIsValid accepts a listed word only, the stems never make an unlisted word valid as the stemmer
+// strips too eagerly, e.g. bukui to buku. On ROOT_ONLY, a listed word having a listed stem is either affixed,
+// like makanan of makan, or a root looking affixed, like kemeja of meja, so ErrorNotFound leaves it to KBBI.
+func (l *Local) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	lemma = strings.ToLower(lemma)
+	listed, err := l.words.LemmaIsValid(ctx, lemma)
+	if err != nil || !listed || l.policy == AFFIXED {
+		return listed, err
+	}
+
+	for _, stem := range Stems(lemma) {
+		rootListed, err := l.words.LemmaIsValid(ctx, stem)
+		if err != nil {
+			return false, err
+		}
+		if rootListed {
+			return false, dictionary.ErrorNotFound
+		}
+	}
+	return true, nil
+}
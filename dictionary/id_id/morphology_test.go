@@ -0,0 +1,74 @@
+package id_id_test
+
+import (
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/id_id"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicy(t *testing.T) {
+	testCases := []struct {
+		raw    string
+		policy id_id.Policy
+		err    error
+	}{
+		{"", id_id.AFFIXED, nil},
+		{"affixed", id_id.AFFIXED, nil},
+		{"ROOT_ONLY", id_id.ROOT_ONLY, nil},
+		{"stemmed", id_id.AFFIXED, id_id.ErrorUnknownPolicy},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.raw, func(t *testing.T) {
+			policy, err := id_id.ParsePolicy(tc.raw)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.policy, policy)
+		})
+	}
+}
+
+func TestCacheLanguages(t *testing.T) {
+	assert.Equal(t, []string{"id-id", "id-id.root"}, id_id.CacheLanguages("id-id"))
+	assert.Equal(t, []string{"en-us"}, id_id.CacheLanguages("en-us"))
+}
+
+func TestLocal_LemmaIsValid(t *testing.T) {
+	words := word_list.NewWordList([]string{"makan", "makanan", "main", "buku", "beras", "meja", "kemeja", "dimakan", "permainan", "pertanian"})
+
+	testCases := []struct {
+		lemma   string
+		affixed bool
+		root    bool
+		rootErr error
+	}{
+		{"makan", true, true, nil},
+		{"makanan", true, false, dictionary.ErrorNotFound}, // listed, yet maybe derived from makan
+		{"kemeja", true, false, dictionary.ErrorNotFound},  // a root looking like ke-meja
+		{"beras", true, true, nil},                         // as is not a stem, it is too short
+		{"memakan", false, false, nil},                     // affixed forms are to be listed themselves
+		{"dimakan", true, false, dictionary.ErrorNotFound}, // listed on its own, its root makan is listed too
+		{"permainan", true, false, dictionary.ErrorNotFound},
+		{"pertanian", true, true, nil}, // listed on its own without tani, best effort takes it as a root
+		{"bermain", false, false, nil},
+		{"buku-buku", false, false, nil},
+		{"memakanan", false, false, nil}, // over-stripped to makan
+		{"bukui", false, false, nil},
+		{"termakankan", false, false, nil},
+		{"tulis", false, false, nil},
+		{"menulis", false, false, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lemma, func(t *testing.T) {
+			valid, err := id_id.NewLocal(words, id_id.AFFIXED).LemmaIsValid(ctx, tc.lemma)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.affixed, valid, "affixed")
+			}
+			valid, err = id_id.NewLocal(words, id_id.ROOT_ONLY).LemmaIsValid(ctx, tc.lemma)
+			assert.Equal(t, tc.rootErr, err, "root only")
+			assert.Equal(t, tc.root, valid, "root only")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package id_id
+
+import (
+	"context"
+	"sync"
+)
+
+type call struct {
+	done     chan struct{}
+	result   bool
+	err      error
+	canceled bool // the context of the first caller was done, the error is not of the lookup
+}
+
+// group collapses concurrent lookups of the same lemma into one request.
+// The request runs on the context of the first caller, the others stop waiting on their own context.
+// When the first caller gives up, the others still waiting make the request again.
+type group struct {
+	mutex sync.Mutex
+	calls map[string]*call
+}
+
+func (g *group) do(ctx context.Context, key string, fn func(context.Context) (bool, error)) (bool, error) {
+	for {
+		g.mutex.Lock()
+		if g.calls == nil {
+			g.calls = make(map[string]*call)
+		}
+		c, ok := g.calls[key]
+		if !ok {
+			break
+		}
+		g.mutex.Unlock()
+		select {
+		case <-c.done:
+			if c.canceled && ctx.Err() == nil {
+				continue
+			}
+			return c.result, c.err
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	c := &call{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mutex.Unlock()
+
+	c.result, c.err = fn(ctx)
+	c.canceled = ctx.Err() != nil
+
+	g.mutex.Lock()
+	delete(g.calls, key)
+	g.mutex.Unlock()
+	close(c.done)
+
+	return c.result, c.err
+}
@@ -0,0 +1,115 @@
+package id_id
+
+import "strings"
+
+// Shorter stems are too often an unrelated word, like mak of makan or sih of bersih.
+const (
+	minimumInflectedStemLength = 3
+	minimumDerivedStemLength   = 4
+)
+
+var (
+	particles   = []string{"lah", "kah", "tah", "pun"}
+	possessives = []string{"nya", "ku", "mu"}
+	suffixes    = []string{"kan", "an", "i"}
+)
+
+// prefix is removed from the word, trying each replacement as the first letters of the stem.
+// The empty replacement keeps the stem as it is, e.g. menulis to tulis needs "t" back.
+type prefix struct {
+	prefix       string
+	replacements []string
+}
+
+// every matching prefix is tried, memakan is me-makan while memukul is mem-pukul
+var prefixes = []prefix{
+	{"memper", []string{""}},
+	{"meng", []string{"", "k"}},
+	{"meny", []string{"s"}},
+	{"mem", []string{"", "p"}},
+	{"men", []string{"", "t"}},
+	{"me", []string{""}},
+	{"peng", []string{"", "k"}},
+	{"peny", []string{"s"}},
+	{"pem", []string{"", "p"}},
+	{"pen", []string{"", "t"}},
+	{"per", []string{""}},
+	{"pe", []string{""}},
+	{"ber", []string{""}},
+	{"be", []string{""}},
+	{"ter", []string{""}},
+	{"di", []string{""}},
+	{"ke", []string{""}},
+	{"se", []string{""}},
+}
+
+// Stems lists the candidate roots of an Indonesian word, from the least to the most stripped.
+// The stemmer does not know any dictionary, so the caller picks the candidates it knows.
+func Stems(word string) []string {
+	word = strings.ToLower(word)
+	var stems []string
+	seen := map[string]bool{word: true}
+	add := func(stem string) {
+		if seen[stem] {
+			return
+		}
+		seen[stem] = true
+		stems = append(stems, stem)
+	}
+
+	// reduplication, buku-buku or bukubuku
+	if i := strings.Index(word, "-"); i > 0 && word[:i] == word[i+1:] {
+		add(word[:i])
+		word = word[:i]
+	} else if half := len(word) / 2; len(word)%2 == 0 && half >= minimumInflectedStemLength && word[:half] == word[half:] {
+		add(word[:half])
+		word = word[:half]
+	}
+
+	// inflectional suffixes, a particle then a possessive
+	base := trimSuffix(word, particles, minimumInflectedStemLength)
+	add(base)
+	base = trimSuffix(base, possessives, minimumInflectedStemLength)
+	add(base)
+
+	bases := []string{base}
+	if derived := trimSuffix(base, suffixes, minimumDerivedStemLength); derived != base {
+		add(derived)
+		bases = append(bases, derived)
+	}
+
+	// derivational prefixes, at most two of them like memper-, diper- or keber-
+	for _, b := range bases {
+		for _, once := range trimPrefix(b) {
+			add(once)
+			for _, twice := range trimPrefix(once) {
+				add(twice)
+			}
+		}
+	}
+	return stems
+}
+
+func trimSuffix(word string, suffixes []string, minimumLength int) string {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= minimumLength {
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
+
+func trimPrefix(word string) (stems []string) {
+	for _, p := range prefixes {
+		if !strings.HasPrefix(word, p.prefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(word, p.prefix)
+		for _, replacement := range p.replacements {
+			if len(replacement+rest) >= minimumDerivedStemLength {
+				stems = append(stems, replacement+rest)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,48 @@
+package id_id_test
+
+import (
+	"github.com/satriahrh/letter-block/dictionary/id_id"
+
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStems(t *testing.T) {
+	testCases := []struct {
+		word string
+		stem string
+	}{
+		{"makanan", "makan"},
+		{"bermain", "main"},
+		{"bekerja", "kerja"},
+		{"menulis", "tulis"},
+		{"memukul", "pukul"},
+		{"membaca", "baca"},
+		{"menyapu", "sapu"},
+		{"mengambil", "ambil"},
+		{"mengirim", "kirim"},
+		{"dimakan", "makan"},
+		{"permainan", "main"},
+		{"memperbaiki", "baik"},
+		{"bukunya", "buku"},
+		{"apakah", "apa"},
+		{"buku-buku", "buku"},
+		{"ibu-ibu", "ibu"},
+		{"Makanan", "makan"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.word, func(t *testing.T) {
+			assert.Contains(t, id_id.Stems(tc.word), tc.stem)
+		})
+	}
+
+	t.Run("ShortStemsAreKept", func(t *testing.T) {
+		assert.NotContains(t, id_id.Stems("makan"), "mak")
+		assert.NotContains(t, id_id.Stems("bersih"), "sih")
+		assert.NotContains(t, id_id.Stems("papa"), "pa")
+	})
+	t.Run("RootHasNoStem", func(t *testing.T) {
+		assert.Empty(t, id_id.Stems("buku"))
+	})
+}
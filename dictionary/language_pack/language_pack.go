@@ -0,0 +1,110 @@
+package language_pack
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary/hunspell"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	ErrorPackInvalid = errors.New("language pack invalid")
+)
+
+// Pack adds a language with no new code, its tiles and the Hunspell dictionary validating its words.
+//
+//	language: nl
+//	dictionary: nl-nl
+//	tiles:
+//	  letters: [a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x, z, ij]
+//	  distribution: [6, 2, 2, 5, 18, 2, 3, 2, 4, 2, 3, 3, 3, 10, 6, 2, 1, 5, 5, 5, 3, 2, 2, 1, 2, 1]
+//	  points: [1, 3, 5, 2, 1, 4, 3, 4, 1, 4, 3, 3, 3, 1, 1, 3, 10, 2, 2, 2, 4, 4, 5, 8, 4, 8]
+//	hunspell:
+//	  dic: nl_NL.dic # relative to the pack file
+//	  aff: nl_NL.aff
+type Pack struct {
+	Language   string `yaml:"language"`
+	Dictionary string `yaml:"dictionary"`
+	Tiles      struct {
+		Letters      []string `yaml:"letters"` // without the blank, a letter may be more than one character
+		Distribution []int    `yaml:"distribution"`
+		Points       []int    `yaml:"points"`
+	} `yaml:"tiles"`
+	Hunspell struct {
+		Dic string `yaml:"dic"`
+		Aff string `yaml:"aff"`
+	} `yaml:"hunspell"`
+}
+
+// Open reads the pack on path, resolving the Hunspell files next to it.
+func Open(path string) (pack Pack, err error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if err = yaml.UnmarshalStrict(raw, &pack); err != nil {
+		return
+	}
+	if pack.Language == "" || pack.Dictionary == "" || pack.Hunspell.Dic == "" || pack.Hunspell.Aff == "" {
+		err = ErrorPackInvalid
+		return
+	}
+
+	dir := filepath.Dir(path)
+	if !filepath.IsAbs(pack.Hunspell.Dic) {
+		pack.Hunspell.Dic = filepath.Join(dir, pack.Hunspell.Dic)
+	}
+	if !filepath.IsAbs(pack.Hunspell.Aff) {
+		pack.Hunspell.Aff = filepath.Join(dir, pack.Hunspell.Aff)
+	}
+	return
+}
+
+// Register makes the pack tiles playable and returns its dictionary.
+func (p Pack) Register() (*hunspell.Hunspell, error) {
+	dict, err := hunspell.Open(p.Hunspell.Dic, p.Hunspell.Aff)
+	if err != nil {
+		return nil, err
+	}
+	err = data.RegisterTiles(p.Language, data.Tiles{
+		Distribution: p.Tiles.Distribution,
+		Points:       p.Tiles.Points,
+		Letters:      append([]string{""}, p.Tiles.Letters...),
+		Dictionary:   p.Dictionary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dict, nil
+}
+
+// Load registers every pack, *.yaml, in the dir and returns their dictionaries by dictionary language.
+func Load(dir string) (map[string]*hunspell.Hunspell, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	dictionaries := make(map[string]*hunspell.Hunspell)
+	for _, path := range paths {
+		pack, err := Open(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, exist := dictionaries[pack.Dictionary]; exist {
+			return nil, data.ErrorLanguageExist
+		}
+		dict, err := pack.Register()
+		if err != nil {
+			return nil, err
+		}
+		dictionaries[pack.Dictionary] = dict
+	}
+	return dictionaries, nil
+}
@@ -0,0 +1,61 @@
+package language_pack_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary/language_pack"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpen(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		pack, err := language_pack.Open("test/xx.yaml")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "xx", pack.Language)
+			assert.Equal(t, "xx-xx", pack.Dictionary)
+			assert.Equal(t, "test/xx_XX.dic", pack.Hunspell.Dic, "relative to the pack")
+		}
+	})
+	t.Run("ErrorFileNotExist", func(t *testing.T) {
+		_, err := language_pack.Open("test/not_exist.yaml")
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("ErrorPackInvalid", func(t *testing.T) {
+		_, err := language_pack.Open("test/invalid/yy.yaml")
+		assert.EqualError(t, err, language_pack.ErrorPackInvalid.Error())
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("ErrorPackInvalid", func(t *testing.T) {
+		_, err := language_pack.Load("test/invalid")
+		assert.EqualError(t, err, language_pack.ErrorPackInvalid.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		dictionaries, err := language_pack.Load("test")
+		if !assert.NoError(t, err) || !assert.Contains(t, dictionaries, "xx-xx") {
+			t.FailNow()
+		}
+
+		valid, err := dictionaries["xx-xx"].LemmaIsValid(context.Background(), "cats")
+		if assert.NoError(t, err) {
+			assert.True(t, valid)
+		}
+		letterBank, err := data.NewLetterBank("xx")
+		if assert.NoError(t, err) {
+			assert.Len(t, letterBank, 98)
+		}
+		dictionary, err := data.DictionaryLanguage("xx")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "xx-xx", dictionary)
+		}
+	})
+	t.Run("ErrorLanguageExist", func(t *testing.T) {
+		_, err := language_pack.Load("test")
+		assert.EqualError(t, err, data.ErrorLanguageExist.Error(), "loaded already")
+	})
+}
@@ -0,0 +1,262 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/bloom_filter"
+	"github.com/satriahrh/letter-block/dictionary/composite"
+	"github.com/satriahrh/letter-block/dictionary/en_us"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
+	"github.com/satriahrh/letter-block/dictionary/id_id"
+	"github.com/satriahrh/letter-block/dictionary/language_pack"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+	"github.com/satriahrh/letter-block/dictionary/word_override"
+)
+
+var (
+	ErrorBloomFilterLanguage = errors.New("bloom filter is only available on a language with an online dictionary")
+	ErrorBloomFilterConfig   = errors.New("bloom filter false positive rate or refresh invalid")
+)
+
+const (
+	defaultFalsePositiveRate = 0.01
+	defaultRefresh           = time.Hour
+)
+
+// Store is satisfied by data.Transactional
+type Store interface {
+	word_override.Store
+	GetValidLemmaWords(ctx context.Context, language string) ([]string, error)
+	GetValidOverrideWords(ctx context.Context, language string) ([]string, error)
+}
+
+// Load builds the dictionary of every configured language, by dictionary language, as told by the environment:
+// ID_ID_MORPHOLOGY, WORD_LIST_ID_ID, WORD_LIST_EN_US, LANGUAGE_PACKS and BLOOM_FILTER_LANGUAGES.
+// The tiles of the language packs are registered too. The bloom filters are loaded, to be refreshed by the caller.
+func Load(store Store, cache data.Dictionary) (map[string]dictionary.Dictionary, *BloomFilters, error) {
+	filters, err := loadBloomFilters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idIdPolicy, err := id_id.ParsePolicy(os.Getenv("ID_ID_MORPHOLOGY"))
+	if err != nil {
+		return nil, nil, err
+	}
+	idId, err := newDictionary("id-id", id_id.CacheLanguage(idIdPolicy), store, cache, id_id.NewIdId(
+		cache,
+		// stay polite to KBBI, it bans aggressive clients
+		http_client.NewClient("kbbi", &http.Client{}, http_client.Config{RequestsPerSecond: 2, Burst: 4}),
+		idIdPolicy,
+	).Online(), func(words dictionary.Dictionary) dictionary.Dictionary {
+		// the word list follows the same morphology policy as KBBI
+		return id_id.NewLocal(words, idIdPolicy)
+	}, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+	dictionaries := map[string]dictionary.Dictionary{
+		"id-id": idId,
+	}
+
+	// English games are only playable with a word list, e.g. WORD_LIST_EN_US=/usr/share/dict/words
+	if path := os.Getenv("WORD_LIST_EN_US"); path != "" {
+		enUs, err := en_us.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Printf("using word list %v with %v words for en-us", path, enUs.Len())
+		dictionaries["en-us"] = withOverride("en-us", store, "word_list", enUs)
+	}
+
+	// more languages, each with its tiles and Hunspell dictionary
+	if dir := os.Getenv("LANGUAGE_PACKS"); dir != "" {
+		packs, err := language_pack.Load(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		for dictionaryLanguage, dict := range packs {
+			if _, exist := dictionaries[dictionaryLanguage]; exist {
+				return nil, nil, data.ErrorLanguageExist
+			}
+			log.Printf("using language pack with %v stems for %v", dict.Len(), dictionaryLanguage)
+			dictionaries[dictionaryLanguage] = withOverride(dictionaryLanguage, store, "language_pack", dict)
+		}
+	}
+
+	// the other dictionaries are in memory, a filter would save nothing
+	for language := range filters.languages {
+		if _, guarded := filters.filters[language]; !guarded {
+			return nil, nil, ErrorBloomFilterLanguage
+		}
+	}
+
+	return dictionaries, filters, nil
+}
+
+// BloomFilters are the filters of the languages told by BLOOM_FILTER_LANGUAGES, e.g. id-id,
+// sized by BLOOM_FILTER_FALSE_POSITIVE_RATE, e.g. 0.01, and refreshed every BLOOM_FILTER_REFRESH, e.g. 1h.
+type BloomFilters struct {
+	languages         map[string]bool
+	falsePositiveRate float64
+	refresh           time.Duration
+	filters           map[string]*bloom_filter.BloomFilter
+}
+
+func loadBloomFilters() (*BloomFilters, error) {
+	filters := &BloomFilters{
+		languages:         make(map[string]bool),
+		falsePositiveRate: defaultFalsePositiveRate,
+		refresh:           defaultRefresh,
+		filters:           make(map[string]*bloom_filter.BloomFilter),
+	}
+	if raw := os.Getenv("BLOOM_FILTER_FALSE_POSITIVE_RATE"); raw != "" {
+		var err error
+		filters.falsePositiveRate, err = strconv.ParseFloat(raw, 64)
+		if err != nil || filters.falsePositiveRate <= 0 || filters.falsePositiveRate >= 1 {
+			return nil, ErrorBloomFilterConfig
+		}
+	}
+	if raw := os.Getenv("BLOOM_FILTER_REFRESH"); raw != "" {
+		var err error
+		filters.refresh, err = time.ParseDuration(raw)
+		if err != nil || filters.refresh <= 0 {
+			return nil, ErrorBloomFilterConfig
+		}
+	}
+	for _, language := range strings.Split(os.Getenv("BLOOM_FILTER_LANGUAGES"), ",") {
+		if language = strings.TrimSpace(language); language != "" {
+			filters.languages[language] = true
+		}
+	}
+	return filters, nil
+}
+
+// Refresh reloads every filter in the background until the context is done,
+// so the words found valid since are not rejected for long.
+func (b *BloomFilters) Refresh(ctx context.Context) {
+	for _, filter := range b.filters {
+		go filter.Refresh(ctx, b.refresh)
+	}
+}
+
+// load builds the filter of the language, when configured, from its known valid words: the lemmas found valid,
+// cached under cacheLanguage, the approved overrides and the word list. Nil is returned otherwise.
+func (b *BloomFilters) load(language, cacheLanguage string, store Store, wordList []string) (*bloom_filter.BloomFilter, error) {
+	if !b.languages[language] {
+		return nil, nil
+	}
+	filter := bloom_filter.NewBloomFilter(language, nil, func(ctx context.Context) ([]string, error) {
+		lemmas, err := store.GetValidLemmaWords(ctx, cacheLanguage)
+		if err != nil {
+			return nil, err
+		}
+		overrides, err := store.GetValidOverrideWords(ctx, language)
+		if err != nil {
+			return nil, err
+		}
+		words := make([]string, 0, len(lemmas)+len(overrides)+len(wordList))
+		words = append(words, lemmas...)
+		words = append(words, overrides...)
+		return append(words, wordList...), nil
+	}, b.falsePositiveRate)
+	if err := filter.Load(context.Background()); err != nil {
+		return nil, err
+	}
+	b.filters[language] = filter
+	return filter, nil
+}
+
+// newDictionary chains the moderator overrides, the local word list, the bloom filter, the verdict cache
+// and the online dictionary of the language. WORD_LIST_ID_ID=path/to/words.txt adds a word list to id-id,
+// its rejection is only authoritative with WORD_LIST_ID_ID_AUTHORITATIVE=true. Verdicts are cached under cacheLanguage,
+// and local wraps the word list if given. The filter rejects the words not known valid without any I/O.
+func newDictionary(
+	language, cacheLanguage string, store Store, cache data.Dictionary, online dictionary.Dictionary,
+	local func(dictionary.Dictionary) dictionary.Dictionary, filters *BloomFilters,
+) (dictionary.Dictionary, error) {
+	providers := []composite.Provider{overrideProvider(language, store)}
+
+	var listed []string
+	envKey := "WORD_LIST_" + strings.ToUpper(strings.Replace(language, "-", "_", -1))
+	if path := os.Getenv(envKey); path != "" {
+		wordList, err := word_list.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("using word list %v with %v words for %v", path, wordList.Len(), language)
+		var words dictionary.Dictionary = wordList
+		if local != nil {
+			words = local(words)
+		}
+		listed = wordList.Words()
+		providers = append(providers, composite.Provider{
+			Name:                  "word_list",
+			Dictionary:            words,
+			PositiveAuthoritative: true,
+			NegativeAuthoritative: os.Getenv(envKey+"_AUTHORITATIVE") == "true",
+		})
+	}
+
+	filter, err := filters.load(language, cacheLanguage, store, listed)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		providers = append(providers, composite.Provider{
+			Name:                  "bloom_filter",
+			Dictionary:            filter,
+			PositiveAuthoritative: true,
+			NegativeAuthoritative: true,
+		})
+	}
+
+	providers = append(providers,
+		composite.Provider{
+			Name:                  "cache",
+			Dictionary:            composite.NewCache(cache, cacheLanguage),
+			PositiveAuthoritative: true,
+			NegativeAuthoritative: true,
+		},
+		composite.Provider{
+			Name:                  "online",
+			Dictionary:            online,
+			PositiveAuthoritative: true,
+			NegativeAuthoritative: true,
+		},
+	)
+
+	return composite.NewComposite(providers...).Publish(language), nil
+}
+
+// withOverride puts the moderator overrides before the offline dictionary of the language.
+func withOverride(language string, overrides word_override.Store, name string, dict dictionary.Dictionary) dictionary.Dictionary {
+	return composite.NewComposite(
+		overrideProvider(language, overrides),
+		composite.Provider{
+			Name:                  name,
+			Dictionary:            dict,
+			PositiveAuthoritative: true,
+			NegativeAuthoritative: true,
+		},
+	).Publish(language)
+}
+
+// overrideProvider answers the verdicts decided on disputes, authoritative both ways.
+func overrideProvider(language string, overrides word_override.Store) composite.Provider {
+	return composite.Provider{
+		Name:                  "override",
+		Dictionary:            word_override.NewWordOverride(overrides, language),
+		PositiveAuthoritative: true,
+		NegativeAuthoritative: true,
+	}
+}
@@ -0,0 +1,165 @@
+package registry_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = context.Background()
+
+type Store struct {
+	mock.Mock
+}
+
+func (s *Store) GetWordOverride(ctx context.Context, language, word string) (data.WordOverride, bool, error) {
+	args := s.Called(language, word)
+	return args.Get(0).(data.WordOverride), args.Bool(1), args.Error(2)
+}
+
+func (s *Store) GetValidLemmaWords(ctx context.Context, language string) ([]string, error) {
+	args := s.Called(language)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (s *Store) GetValidOverrideWords(ctx context.Context, language string) ([]string, error) {
+	args := s.Called(language)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type Cache struct {
+	mock.Mock
+}
+
+func (c *Cache) Get(ctx context.Context, lang, key string) (bool, bool, error) {
+	args := c.Called(lang, key)
+	return args.Bool(0), args.Bool(1), args.Error(2)
+}
+
+func (c *Cache) GetMany(ctx context.Context, lang string, keys []string) (map[string]bool, error) {
+	args := c.Called(lang, keys)
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (c *Cache) Set(ctx context.Context, lang, key string, value bool, source string) error {
+	return c.Called(lang, key, value, source).Error(0)
+}
+
+func (c *Cache) GetEntry(ctx context.Context, lang, key string) (data.Entry, bool, error) {
+	args := c.Called(lang, key)
+	return args.Get(0).(data.Entry), args.Bool(1), args.Error(2)
+}
+
+func (c *Cache) SetEntry(ctx context.Context, lang, key string, entry data.Entry) error {
+	return c.Called(lang, key, entry).Error(0)
+}
+
+// setenv sets the environment for the test, returning how to restore it
+func setenv(t *testing.T, env map[string]string) func() {
+	previous := make(map[string]string)
+	for key, value := range env {
+		previous[key] = os.Getenv(key)
+		if err := os.Setenv(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return func() {
+		for key, value := range previous {
+			_ = os.Setenv(key, value)
+		}
+	}
+}
+
+func TestLoad_BloomFilter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "id-id.txt")
+	if err := ioutil.WriteFile(path, []byte("makan\nminum\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ErrorBloomFilterLanguage", func(t *testing.T) {
+		defer setenv(t, map[string]string{
+			"BLOOM_FILTER_LANGUAGES": "en-us",
+			"WORD_LIST_EN_US":        "",
+		})()
+
+		_, _, err := registry.Load(&Store{}, &Cache{})
+		assert.Equal(t, registry.ErrorBloomFilterLanguage, err)
+	})
+	t.Run("ErrorBloomFilterConfig", func(t *testing.T) {
+		defer setenv(t, map[string]string{
+			"BLOOM_FILTER_LANGUAGES": "id-id",
+			"BLOOM_FILTER_REFRESH":   "hourly",
+		})()
+
+		_, _, err := registry.Load(&Store{}, &Cache{})
+		assert.Equal(t, registry.ErrorBloomFilterConfig, err)
+	})
+	t.Run("GuardsTheCacheAndOnline", func(t *testing.T) {
+		defer setenv(t, map[string]string{
+			"BLOOM_FILTER_LANGUAGES": "id-id",
+			"WORD_LIST_ID_ID":        path,
+		})()
+
+		store := &Store{}
+		store.On("GetValidLemmaWords", "id-id").
+			Return([]string{"gawai"}, nil)
+		store.On("GetValidOverrideWords", "id-id").
+			Return([]string{"mantul"}, nil)
+		store.On("GetWordOverride", "id-id", "mantul").
+			Return(data.WordOverride{Language: "id-id", Word: "mantul", Valid: true}, true, nil)
+		store.On("GetWordOverride", "id-id", mock.Anything).
+			Return(data.WordOverride{}, false, nil)
+		cache := &Cache{}
+		cache.On("Get", "id-id", "gawai").
+			Return(true, true, nil)
+
+		dictionaries, _, err := registry.Load(store, cache)
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, word := range []string{
+			"makan",  // listed
+			"mantul", // approved override
+			"gawai",  // told valid by KBBI, let through to the cache
+		} {
+			valid, err := dictionaries["id-id"].LemmaIsValid(ctx, word)
+			if assert.NoError(t, err, word) {
+				assert.True(t, valid, word)
+			}
+		}
+
+		// neither the cache nor KBBI is asked
+		valid, err := dictionaries["id-id"].LemmaIsValid(ctx, "qwzx")
+		if assert.NoError(t, err) {
+			assert.False(t, valid)
+		}
+		cache.AssertNotCalled(t, "Get", "id-id", "qwzx")
+	})
+	t.Run("ErrorLoadingTheKnownWords", func(t *testing.T) {
+		defer setenv(t, map[string]string{
+			"BLOOM_FILTER_LANGUAGES": "id-id",
+			"WORD_LIST_ID_ID":        path,
+		})()
+
+		store := &Store{}
+		store.On("GetValidLemmaWords", "id-id").
+			Return([]string(nil), assert.AnError)
+
+		_, _, err := registry.Load(store, &Cache{})
+		assert.Equal(t, assert.AnError, err)
+	})
+}
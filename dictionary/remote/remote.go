@@ -0,0 +1,112 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
+)
+
+// HttpClient is satisfied by http_client.Client
+type HttpClient interface {
+	Get(ctx context.Context, url string) (*http.Response, error)
+}
+
+// Remote validates lemma of the language by asking a Server, so game servers share one dictionary wiring.
+// ErrorNotFound is returned when the server does not serve the language.
+type Remote struct {
+	baseUrl    string
+	language   string
+	httpClient HttpClient
+}
+
+func NewRemote(baseUrl, language string, httpClient HttpClient) *Remote {
+	return &Remote{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		language:   language,
+		httpClient: httpClient,
+	}
+}
+
+func (r *Remote) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	verdict, err := r.Verdict(ctx, lemma)
+	return verdict.Valid, err
+}
+
+// Verdict tells the provider deciding on the server side, e.g. cache or online.
+func (r *Remote) Verdict(ctx context.Context, lemma string) (dictionary.Verdict, error) {
+	var lookup Lookup
+	err := r.get(ctx, fmt.Sprintf("%v/lemmas/%v/%v", r.baseUrl, r.language, url.PathEscape(lemma)), &lookup)
+	return lookup.Verdict, err
+}
+
+// LemmasAreValid looks the lemmas up in batches, the verdicts are by lemma.
+func (r *Remote) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	verdicts := make(map[string]dictionary.Verdict, len(lemmas))
+	for start := 0; start < len(lemmas); start += maximumBatch {
+		end := start + maximumBatch
+		if end > len(lemmas) {
+			end = len(lemmas)
+		}
+		query := url.Values{"lemma": lemmas[start:end]}
+
+		var lookups []Lookup
+		err := r.get(ctx, fmt.Sprintf("%v/lemmas/%v?%v", r.baseUrl, r.language, query.Encode()), &lookups)
+		if err != nil {
+			return nil, err
+		}
+		for _, lookup := range lookups {
+			verdicts[lookup.Lemma] = lookup.Verdict
+		}
+	}
+	return verdicts, nil
+}
+
+func (r *Remote) Define(ctx context.Context, lemma string) (entry data.Entry, err error) {
+	err = r.get(ctx, fmt.Sprintf("%v/definitions/%v/%v", r.baseUrl, r.language, url.PathEscape(lemma)), &entry)
+	return
+}
+
+func (r *Remote) get(ctx context.Context, url string, value interface{}) error {
+	res, err := r.httpClient.Get(ctx, url)
+	if err != nil {
+		return providerError(ctx, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	// no entry of the lemma, or the language is not served
+	if res.StatusCode == http.StatusNotFound {
+		return dictionary.ErrorNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		log.Printf("unexpected dictionary server response status %v on %v", res.StatusCode, url)
+		return dictionary.ErrorProviderUnavailable
+	}
+
+	body := struct {
+		Data interface{} `json:"data"`
+	}{value}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return providerError(ctx, err)
+	}
+	return nil
+}
+
+func providerError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == http_client.ErrorRateLimited {
+		return dictionary.ErrorRateLimited
+	}
+	log.Println(err)
+	return dictionary.ErrorProviderUnavailable
+}
@@ -0,0 +1,197 @@
+package remote_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/http_client"
+	"github.com/satriahrh/letter-block/dictionary/remote"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Composite stands for a dictionary telling the deciding provider and the definitions
+type Composite struct {
+	mock.Mock
+}
+
+func (c *Composite) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	verdict, err := c.Verdict(ctx, lemma)
+	return verdict.Valid, err
+}
+
+func (c *Composite) Verdict(ctx context.Context, lemma string) (dictionary.Verdict, error) {
+	args := c.Called(lemma)
+	return args.Get(0).(dictionary.Verdict), args.Error(1)
+}
+
+func (c *Composite) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	args := c.Called(lemma)
+	return args.Get(0).(data.Entry), args.Error(1)
+}
+
+var ctx = context.Background()
+
+func suiteRemote(language string) (*remote.Remote, *Composite, func()) {
+	composite := &Composite{}
+	server := httptest.NewServer(remote.NewServer(map[string]dictionary.Dictionary{
+		"id-id": composite,
+		"en-us": word_list.NewWordList([]string{"eat", "drink"}),
+	}))
+
+	httpClient := http_client.NewClient("remote_test", server.Client(), http_client.Config{
+		MaxRetries:  1,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+	return remote.NewRemote(server.URL+"/", language, httpClient), composite, server.Close
+}
+
+func TestRemote_Verdict(t *testing.T) {
+	t.Run("Arbiter", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		composite.On("Verdict", "makan").
+			Return(dictionary.Verdict{Valid: true, Source: "online"}, nil)
+
+		verdict, err := dict.Verdict(ctx, "makan")
+		if assert.NoError(t, err) {
+			assert.Equal(t, dictionary.Verdict{Valid: true, Source: "online"}, verdict)
+		}
+	})
+	t.Run("Dictionary", func(t *testing.T) {
+		dict, _, closeServer := suiteRemote("en-us")
+		defer closeServer()
+
+		valid, err := dict.LemmaIsValid(ctx, "eat")
+		if assert.NoError(t, err) {
+			assert.True(t, valid)
+		}
+		valid, err = dict.LemmaIsValid(ctx, "sleep")
+		if assert.NoError(t, err) {
+			assert.False(t, valid)
+		}
+	})
+	t.Run("ErrorRateLimited", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		composite.On("Verdict", "makan").
+			Return(dictionary.Verdict{}, dictionary.ErrorRateLimited)
+
+		_, err := dict.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorRateLimited.Error())
+	})
+	t.Run("ErrorProviderUnavailable", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		composite.On("Verdict", "makan").
+			Return(dictionary.Verdict{}, dictionary.ErrorProviderUnavailable)
+
+		_, err := dict.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+		_, err = dict.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+		composite.AssertNumberOfCalls(t, "Verdict", 1)
+	})
+	t.Run("ErrorLanguageNotServed", func(t *testing.T) {
+		dict, _, closeServer := suiteRemote("xx-xx")
+		defer closeServer()
+
+		_, err := dict.Verdict(ctx, "makan")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+}
+
+func TestRemote_LemmasAreValid(t *testing.T) {
+	t.Run("Batches", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		lemmas := make([]string, 150)
+		for i := range lemmas {
+			lemmas[i] = strings.Repeat("a", i+1)
+			composite.On("Verdict", lemmas[i]).
+				Return(dictionary.Verdict{Valid: i%2 == 0, Source: "cache"}, nil)
+		}
+
+		verdicts, err := dict.LemmasAreValid(ctx, lemmas)
+		if assert.NoError(t, err) && assert.Len(t, verdicts, 150) {
+			assert.Equal(t, dictionary.Verdict{Valid: true, Source: "cache"}, verdicts["a"])
+			assert.Equal(t, dictionary.Verdict{Valid: false, Source: "cache"}, verdicts["aa"])
+		}
+	})
+	t.Run("ErrorProviderUnavailable", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		composite.On("Verdict", "makan").
+			Return(dictionary.Verdict{Valid: true}, nil)
+		composite.On("Verdict", "mkn").
+			Return(dictionary.Verdict{}, dictionary.ErrorProviderUnavailable)
+
+		_, err := dict.LemmasAreValid(ctx, []string{"makan", "mkn"})
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
+
+func TestRemote_Define(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		entry := data.Entry{Lemma: "ma.kan", Class: "Verba", Definitions: []string{"memasukkan makanan"}}
+		composite.On("Define", "makan").
+			Return(entry, nil)
+
+		actual, err := dict.Define(ctx, "makan")
+		if assert.NoError(t, err) {
+			assert.Equal(t, entry, actual)
+		}
+	})
+	t.Run("ErrorNotFound", func(t *testing.T) {
+		dict, composite, closeServer := suiteRemote("id-id")
+		defer closeServer()
+		composite.On("Define", "mkn").
+			Return(data.Entry{}, dictionary.ErrorNotFound)
+
+		_, err := dict.Define(ctx, "mkn")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+	t.Run("ErrorNotDefiner", func(t *testing.T) {
+		dict, _, closeServer := suiteRemote("en-us")
+		defer closeServer()
+
+		_, err := dict.Define(ctx, "eat")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+}
+
+func TestServer(t *testing.T) {
+	server := remote.NewServer(map[string]dictionary.Dictionary{
+		"id-id": &Composite{},
+		"en-us": word_list.NewWordList(nil),
+	})
+	t.Run("Languages", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/languages", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":["en-us","id-id"]}`, w.Body.String())
+	})
+	t.Run("ErrorBatchSize", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/lemmas/id-id", nil))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		w = httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/lemmas/id-id?"+strings.Repeat("lemma=a&", 101), nil))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
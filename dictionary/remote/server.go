@@ -0,0 +1,175 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// maximumBatch bounds the lemmas of a batch lookup, Remote splits bigger batches
+const maximumBatch = 100
+
+// retryAfter is told on 503, the providers behind the server were already retried
+const retryAfter = 10 * time.Second
+
+// Lookup is the verdict of a lemma as answered by the Server.
+type Lookup struct {
+	Lemma string `json:"lemma"`
+	dictionary.Verdict
+}
+
+// Server answers lookups on the dictionaries over HTTP/JSON:
+//
+//	GET /languages                         the dictionary languages served
+//	GET /lemmas/{language}/{lemma}         the verdict of a lemma
+//	GET /lemmas/{language}?lemma=a&lemma=b the verdicts of up to 100 lemmas
+//	GET /definitions/{language}/{lemma}    the entry of a lemma
+type Server struct {
+	dictionaries map[string]dictionary.Dictionary
+	router       chi.Router
+}
+
+func NewServer(dictionaries map[string]dictionary.Dictionary) *Server {
+	s := &Server{
+		dictionaries: dictionaries,
+		router:       chi.NewRouter(),
+	}
+	s.router.Get("/languages", s.languages)
+	s.router.Get("/lemmas/{language}", s.lemmas)
+	s.router.Get("/lemmas/{language}/{lemma}", s.lemma)
+	s.router.Get("/definitions/{language}/{lemma}", s.definition)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+func (s *Server) languages(w http.ResponseWriter, r *http.Request) {
+	languages := make([]string, 0, len(s.dictionaries))
+	for language := range s.dictionaries {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	successResponse(w, languages)
+}
+
+func (s *Server) lemma(w http.ResponseWriter, r *http.Request) {
+	dict, ok := s.dictionary(w, r)
+	if !ok {
+		return
+	}
+	lemma := chi.URLParam(r, "lemma")
+	verdict, err := verdict(r.Context(), dict, lemma)
+	if err != nil {
+		lookupError(w, r.Context(), err)
+		return
+	}
+	successResponse(w, Lookup{lemma, verdict})
+}
+
+func (s *Server) lemmas(w http.ResponseWriter, r *http.Request) {
+	dict, ok := s.dictionary(w, r)
+	if !ok {
+		return
+	}
+	lemmas := r.URL.Query()["lemma"]
+	if len(lemmas) == 0 || len(lemmas) > maximumBatch {
+		errorResponse(w, http.StatusUnprocessableEntity, "lemma should be given from 1 to 100 times")
+		return
+	}
+
+	verdicts, err := dictionary.LemmasAreValid(r.Context(), dict, lemmas)
+	if err != nil {
+		lookupError(w, r.Context(), err)
+		return
+	}
+	// the lemmas without a verdict are left out
+	lookups := make([]Lookup, 0, len(verdicts))
+	for _, lemma := range lemmas {
+		if verdict, ok := verdicts[lemma]; ok {
+			lookups = append(lookups, Lookup{lemma, verdict})
+			delete(verdicts, lemma)
+		}
+	}
+	successResponse(w, lookups)
+}
+
+func (s *Server) definition(w http.ResponseWriter, r *http.Request) {
+	dict, ok := s.dictionary(w, r)
+	if !ok {
+		return
+	}
+	definer, ok := dict.(dictionary.Definer)
+	if !ok {
+		errorResponse(w, http.StatusNotFound, dictionary.ErrorNotFound.Error())
+		return
+	}
+	entry, err := definer.Define(r.Context(), chi.URLParam(r, "lemma"))
+	if err == dictionary.ErrorNotFound {
+		errorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		lookupError(w, r.Context(), err)
+		return
+	}
+	successResponse(w, entry)
+}
+
+func (s *Server) dictionary(w http.ResponseWriter, r *http.Request) (dictionary.Dictionary, bool) {
+	dict, ok := s.dictionaries[chi.URLParam(r, "language")]
+	if !ok {
+		errorResponse(w, http.StatusNotFound, "language not served")
+	}
+	return dict, ok
+}
+
+func verdict(ctx context.Context, dict dictionary.Dictionary, lemma string) (verdict dictionary.Verdict, err error) {
+	if arbiter, ok := dict.(dictionary.Arbiter); ok {
+		return arbiter.Verdict(ctx, lemma)
+	}
+	verdict.Valid, err = dict.LemmaIsValid(ctx, lemma)
+	return
+}
+
+// lookupError tells the rate limit as 429, retried by Remote, and any other failure as 503,
+// which Remote does not retry before Retry-After.
+func lookupError(w http.ResponseWriter, ctx context.Context, err error) {
+	if err == dictionary.ErrorRateLimited {
+		errorResponse(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+	if ctx.Err() == nil {
+		log.Println(err)
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter/time.Second)))
+	errorResponse(w, http.StatusServiceUnavailable, dictionary.ErrorProviderUnavailable.Error())
+}
+
+func errorResponse(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(
+		struct {
+			Message string `json:"message"`
+		}{message},
+	)
+}
+
+func successResponse(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_ = json.NewEncoder(w).Encode(
+		struct {
+			Data interface{} `json:"data"`
+		}{data},
+	)
+}
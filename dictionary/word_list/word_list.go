@@ -0,0 +1,112 @@
+package word_list
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// WordList validates lemma against a sorted list of known words held in memory.
+// Useful when the network dictionary is not reachable, like on development and CI.
+type WordList struct {
+	words []string
+}
+
+func NewWordList(words []string) *WordList {
+	normalized := make([]string, 0, len(words))
+	for _, word := range words {
+		word = normalize(word)
+		if word == "" {
+			continue
+		}
+		normalized = append(normalized, word)
+	}
+	sort.Strings(normalized)
+
+	// remove duplicates
+	unique := normalized[:0]
+	for i, word := range normalized {
+		if i > 0 && word == normalized[i-1] {
+			continue
+		}
+		unique = append(unique, word)
+	}
+
+	return &WordList{
+		words: unique,
+	}
+}
+
+// Read loads one word per line, skipping blank lines and lines starting with #.
+func Read(reader io.Reader) (*WordList, error) {
+	var words []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewWordList(words), nil
+}
+
+// Open loads the word list file on path.
+func Open(path string) (*WordList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return Read(file)
+}
+
+func (w *WordList) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	lemma = normalize(lemma)
+	i := sort.SearchStrings(w.words, lemma)
+	return i < len(w.words) && w.words[i] == lemma, nil
+}
+
+// LemmasAreValid looks the lemmas up in one walk over the list, sorting them first.
+func (w *WordList) LemmasAreValid(ctx context.Context, lemmas []string) (map[string]dictionary.Verdict, error) {
+	normalized := make([]string, len(lemmas))
+	order := make([]int, len(lemmas))
+	for i, lemma := range lemmas {
+		normalized[i] = normalize(lemma)
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return normalized[order[a]] < normalized[order[b]]
+	})
+
+	verdicts := make(map[string]dictionary.Verdict, len(lemmas))
+	words := w.words
+	for _, i := range order {
+		words = words[sort.SearchStrings(words, normalized[i]):]
+		verdicts[lemmas[i]] = dictionary.Verdict{Valid: len(words) > 0 && words[0] == normalized[i]}
+	}
+	return verdicts, nil
+}
+
+// Words tells the words of the list, sorted.
+func (w *WordList) Words() []string {
+	return w.words
+}
+
+func (w *WordList) Len() int {
+	return len(w.words)
+}
+
+func normalize(word string) string {
+	return strings.ToLower(strings.TrimSpace(word))
+}
@@ -0,0 +1,83 @@
+package word_list_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/word_list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("unexpected error")
+}
+
+func TestOpen(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		wordList, err := word_list.Open("test/words.txt")
+		if assert.NoError(t, err) {
+			assert.Equal(t, 3, wordList.Len(), "comments, blanks and duplicates are skipped")
+			assert.Equal(t, []string{"makan", "minum", "tidur"}, wordList.Words())
+		}
+	})
+	t.Run("ErrorFileNotExist", func(t *testing.T) {
+		_, err := word_list.Open("test/not_exist.txt")
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("ErrorReading", func(t *testing.T) {
+		_, err := word_list.Read(errorReader{})
+		assert.EqualError(t, err, "unexpected error")
+	})
+}
+
+func TestWordList_LemmaIsValid(t *testing.T) {
+	wordList, err := word_list.Open("test/words.txt")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	testSuite := func(lemma string, expected bool) func(t *testing.T) {
+		return func(t *testing.T) {
+			result, err := wordList.LemmaIsValid(context.Background(), lemma)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, result)
+			}
+		}
+	}
+
+	t.Run("Found", testSuite("makan", true))
+	t.Run("FoundCaseInsensitive", testSuite("MaKan", true))
+	t.Run("FoundListedCapitalized", testSuite("tidur", true))
+	t.Run("NotFound", testSuite("makanan", false))
+	t.Run("NotFoundBeyondLast", testSuite("zzz", false))
+	t.Run("Empty", func(t *testing.T) {
+		result, err := word_list.NewWordList(nil).LemmaIsValid(context.Background(), "makan")
+		if assert.NoError(t, err) {
+			assert.False(t, result)
+		}
+	})
+}
+
+func TestWordList_LemmasAreValid(t *testing.T) {
+	wordList, err := word_list.Open("test/words.txt")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	verdicts, err := wordList.LemmasAreValid(context.Background(), []string{"zzz", "tidur", "makanan", "Makan", "minum"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]dictionary.Verdict{
+			"zzz":     {Valid: false},
+			"tidur":   {Valid: true},
+			"makanan": {Valid: false},
+			"Makan":   {Valid: true},
+			"minum":   {Valid: true},
+		}, verdicts)
+	}
+}
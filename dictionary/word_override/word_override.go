@@ -0,0 +1,41 @@
+package word_override
+
+import (
+	"context"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// Store is satisfied by data.Transactional
+type Store interface {
+	GetWordOverride(ctx context.Context, language, word string) (data.WordOverride, bool, error)
+}
+
+// WordOverride tells the verdicts decided by moderators on disputes, checked before any other dictionary.
+type WordOverride struct {
+	store    Store
+	language string
+}
+
+func NewWordOverride(store Store, language string) *WordOverride {
+	return &WordOverride{
+		store:    store,
+		language: language,
+	}
+}
+
+// LemmaIsValid returns dictionary.ErrorNotFound when no moderator decided on the lemma.
+func (w *WordOverride) LemmaIsValid(ctx context.Context, lemma string) (bool, error) {
+	override, exist, err := w.store.GetWordOverride(ctx, w.language, lemma)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return false, dictionary.ErrorProviderUnavailable
+	}
+	if !exist {
+		return false, dictionary.ErrorNotFound
+	}
+	return override.Valid, nil
+}
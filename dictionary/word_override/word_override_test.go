@@ -0,0 +1,54 @@
+package word_override_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/word_override"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type Store struct {
+	mock.Mock
+}
+
+func (s *Store) GetWordOverride(ctx context.Context, language, word string) (data.WordOverride, bool, error) {
+	args := s.Called(language, word)
+	return args.Get(0).(data.WordOverride), args.Bool(1), args.Error(2)
+}
+
+var ctx = context.Background()
+
+func TestWordOverride_LemmaIsValid(t *testing.T) {
+	t.Run("Overridden", func(t *testing.T) {
+		store := &Store{}
+		store.On("GetWordOverride", "id-id", "word").
+			Return(data.WordOverride{Language: "id-id", Word: "word", Valid: true}, true, nil)
+
+		valid, err := word_override.NewWordOverride(store, "id-id").LemmaIsValid(ctx, "word")
+		if assert.NoError(t, err) {
+			assert.True(t, valid)
+		}
+	})
+	t.Run("ErrorNotFound", func(t *testing.T) {
+		store := &Store{}
+		store.On("GetWordOverride", "id-id", "word").
+			Return(data.WordOverride{}, false, nil)
+
+		_, err := word_override.NewWordOverride(store, "id-id").LemmaIsValid(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorNotFound.Error())
+	})
+	t.Run("ErrorProviderUnavailable", func(t *testing.T) {
+		store := &Store{}
+		store.On("GetWordOverride", "id-id", "word").
+			Return(data.WordOverride{}, false, errors.New("unexpected error"))
+
+		_, err := word_override.NewWordOverride(store, "id-id").LemmaIsValid(ctx, "word")
+		assert.EqualError(t, err, dictionary.ErrorProviderUnavailable.Error())
+	})
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorCodes are told to the player on the "code" error extension, so clients need not parse messages.
+var errorCodes = map[error]string{
+	service.ErrorChallengeNotAllowed:   "CHALLENGE_NOT_ALLOWED",
+	service.ErrorDictionaryBusy:        "DICTIONARY_BUSY",
+	service.ErrorDictionaryUnavailable: "DICTIONARY_UNAVAILABLE",
+	service.ErrorDisputeDecided:        "DISPUTE_DECIDED",
+	service.ErrorDisputeNotFound:       "DISPUTE_NOT_FOUND",
+	service.ErrorDoesntMakeWord:        "DOESNT_MAKE_WORD",
+	service.ErrorGameIsUnplayable:      "GAME_IS_UNPLAYABLE",
+	service.ErrorGameSettings:          "GAME_SETTINGS_INVALID",
+	service.ErrorPlayerIsEnough:        "PLAYER_IS_ENOUGH",
+	service.ErrorNotYourTurn:           "NOT_YOUR_TURN",
+	service.ErrorNoDefinition:          "NO_DEFINITION",
+	service.ErrorNoUndoRequested:       "NO_UNDO_REQUESTED",
+	service.ErrorNumberOfPlayer:        "NUMBER_OF_PLAYER_INVALID",
+	service.ErrorUnauthorized:          "UNAUTHORIZED",
+	service.ErrorUndoNotAllowed:        "UNDO_NOT_ALLOWED",
+	service.ErrorWordHavePlayed:        "WORD_HAVE_PLAYED",
+	service.ErrorWordBanned:            "WORD_BANNED",
+	service.ErrorWordInvalid:           "WORD_INVALID",
+	service.ErrorWordListInvalid:       "WORD_LIST_INVALID",
+	service.ErrorWordNotDisputable:     "WORD_NOT_DISPUTABLE",
+	context.Canceled:                   "CANCELED",
+	context.DeadlineExceeded:           "TIMEOUT",
+}
+
+// ErrorPresenter adds the error code to the errors known by the service, even when wrapped
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+	if code, ok := errorCode(err); ok {
+		if presented.Extensions == nil {
+			presented.Extensions = make(map[string]interface{})
+		}
+		presented.Extensions["code"] = code
+	}
+	return presented
+}
+
+// errorCode unwraps the error until a known one. The codes are compared rather than looked up,
+// as an error of an unhashable type would panic as a map key.
+func errorCode(err error) (string, bool) {
+	for err != nil {
+		for known, code := range errorCodes {
+			if known == err {
+				return code, true
+			}
+		}
+		wrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		err = wrapper.Unwrap()
+	}
+	return "", false
+}
@@ -0,0 +1,76 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/graph"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+)
+
+// wrapped stands for an error annotated on the way up, e.g. by fmt.Errorf with %w
+type wrapped struct {
+	err error
+}
+
+func (w wrapped) Error() string { return "wrapped: " + w.err.Error() }
+
+func (w wrapped) Unwrap() error { return w.err }
+
+// unhashable could not be a map key
+type unhashable []string
+
+func (u unhashable) Error() string { return "unhashable" }
+
+func TestErrorPresenter(t *testing.T) {
+	ctx := context.Background()
+
+	// the codes are relied on by the clients, changing one breaks them
+	for err, code := range map[error]string{
+		service.ErrorChallengeNotAllowed:   "CHALLENGE_NOT_ALLOWED",
+		service.ErrorDictionaryBusy:        "DICTIONARY_BUSY",
+		service.ErrorDictionaryUnavailable: "DICTIONARY_UNAVAILABLE",
+		service.ErrorDisputeDecided:        "DISPUTE_DECIDED",
+		service.ErrorDisputeNotFound:       "DISPUTE_NOT_FOUND",
+		service.ErrorDoesntMakeWord:        "DOESNT_MAKE_WORD",
+		service.ErrorGameIsUnplayable:      "GAME_IS_UNPLAYABLE",
+		service.ErrorGameSettings:          "GAME_SETTINGS_INVALID",
+		service.ErrorPlayerIsEnough:        "PLAYER_IS_ENOUGH",
+		service.ErrorNotYourTurn:           "NOT_YOUR_TURN",
+		service.ErrorNoDefinition:          "NO_DEFINITION",
+		service.ErrorNoUndoRequested:       "NO_UNDO_REQUESTED",
+		service.ErrorNumberOfPlayer:        "NUMBER_OF_PLAYER_INVALID",
+		service.ErrorUnauthorized:          "UNAUTHORIZED",
+		service.ErrorUndoNotAllowed:        "UNDO_NOT_ALLOWED",
+		service.ErrorWordHavePlayed:        "WORD_HAVE_PLAYED",
+		service.ErrorWordBanned:            "WORD_BANNED",
+		service.ErrorWordInvalid:           "WORD_INVALID",
+		service.ErrorWordListInvalid:       "WORD_LIST_INVALID",
+		service.ErrorWordNotDisputable:     "WORD_NOT_DISPUTABLE",
+		context.Canceled:                   "CANCELED",
+		context.DeadlineExceeded:           "TIMEOUT",
+	} {
+		t.Run(code, func(t *testing.T) {
+			presented := graph.ErrorPresenter(ctx, err)
+			assert.Equal(t, err.Error(), presented.Message)
+			assert.Equal(t, code, presented.Extensions["code"])
+
+			presented = graph.ErrorPresenter(ctx, wrapped{wrapped{err}})
+			assert.Equal(t, "wrapped: wrapped: "+err.Error(), presented.Message)
+			assert.Equal(t, code, presented.Extensions["code"], "wrapped")
+		})
+	}
+	t.Run("Unknown", func(t *testing.T) {
+		for _, err := range []error{
+			errors.New("unexpected error"),
+			wrapped{errors.New("unexpected error")},
+			unhashable{"unexpected error"},
+		} {
+			presented := graph.ErrorPresenter(ctx, err)
+			assert.Equal(t, err.Error(), presented.Message)
+			assert.NotContains(t, presented.Extensions, "code")
+		}
+	})
+}
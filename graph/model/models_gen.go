@@ -2,6 +2,25 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type Capture struct {
+	From      *Player `json:"from"`
+	Positions []int   `json:"positions"`
+}
+
+type Dispute struct {
+	ID     string       `json:"id"`
+	Word   string       `json:"word"`
+	Valid  bool         `json:"valid"`
+	Source string       `json:"source"`
+	State  DisputeState `json:"state"`
+}
+
 type Game struct {
 	ID                 string        `json:"id"`
 	CurrentPlayerOrder int           `json:"currentPlayerOrder"`
@@ -10,14 +29,65 @@ type Game struct {
 	BoardBase          []int         `json:"boardBase"`
 	BoardPositioning   []int         `json:"boardPositioning"`
 	NumberOfPlayer     int           `json:"numberOfPlayer"`
+	Settings           *GameSettings `json:"settings"`
+	Scores             []int         `json:"scores"`
+	Ranking            []int         `json:"ranking"`
+	Tiles              []*Tile       `json:"tiles"`
+	UndoRequest        *UndoRequest  `json:"undoRequest"`
+}
+
+type GameSettings struct {
+	Mode             GameMode    `json:"mode"`
+	BonusTiles       bool        `json:"bonusTiles"`
+	Language         string      `json:"language"`
+	HouseRules       *HouseRules `json:"houseRules"`
+	ProvisionalTurns bool        `json:"provisionalTurns"`
+	Challenge        bool        `json:"challenge"`
+}
+
+type HouseRules struct {
+	AllowedWords []string `json:"allowedWords"`
+	BannedWords  []string `json:"bannedWords"`
+	AllowedLists []string `json:"allowedLists"`
+	BannedLists  []string `json:"bannedLists"`
 }
 
 type JoinGame struct {
 	GameID string `json:"gameId"`
 }
 
+type MoveResult struct {
+	Player       *Player    `json:"player"`
+	Word         *string    `json:"word"`
+	Positions    []int      `json:"positions"`
+	Claimed      []int      `json:"claimed"`
+	Captured     []*Capture `json:"captured"`
+	Strengthened []int      `json:"strengthened"`
+	Weakened     []int      `json:"weakened"`
+	Reset        []int      `json:"reset"`
+	Drawn        []string   `json:"drawn"`
+	Pending      bool       `json:"pending"`
+	RolledBack   bool       `json:"rolledBack"`
+	Challenged   bool       `json:"challenged"`
+	Game         *Game      `json:"game"`
+}
+
 type NewGame struct {
-	NumberOfPlayer int `json:"numberOfPlayer"`
+	NumberOfPlayer   int       `json:"numberOfPlayer"`
+	Mode             *GameMode `json:"mode"`
+	BonusTiles       *bool     `json:"bonusTiles"`
+	Language         *string   `json:"language"`
+	AllowedWords     []string  `json:"allowedWords"`
+	BannedWords      []string  `json:"bannedWords"`
+	AllowedLists     []string  `json:"allowedLists"`
+	BannedLists      []string  `json:"bannedLists"`
+	ProvisionalTurns *bool     `json:"provisionalTurns"`
+	Challenge        *bool     `json:"challenge"`
+}
+
+type NewWordList struct {
+	Name  string   `json:"name"`
+	Words []string `json:"words"`
 }
 
 type Player struct {
@@ -25,12 +95,171 @@ type Player struct {
 	Username string `json:"username"`
 }
 
+type RespondUndo struct {
+	GameID string `json:"gameId"`
+	Accept bool   `json:"accept"`
+}
+
 type TakeTurn struct {
 	GameID string `json:"gameId"`
 	Word   []int  `json:"word"`
 }
 
-type WordPlayed struct {
-	Player *Player `json:"player"`
-	Word   string  `json:"word"`
+type Tile struct {
+	Position int          `json:"position"`
+	Letter   string       `json:"letter"`
+	Owner    *Player      `json:"owner"`
+	Strength int          `json:"strength"`
+	Defended bool         `json:"defended"`
+	Modifier TileModifier `json:"modifier"`
+}
+
+type UndoRequest struct {
+	RequestedBy *Player   `json:"requestedBy"`
+	ApprovedBy  []*Player `json:"approvedBy"`
+}
+
+type Word struct {
+	Lemma       string   `json:"lemma"`
+	Class       *string  `json:"class"`
+	Definitions []string `json:"definitions"`
+}
+
+type WordList struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Words []string `json:"words"`
+}
+
+type DisputeState string
+
+const (
+	DisputeStatePending  DisputeState = "PENDING"
+	DisputeStateApproved DisputeState = "APPROVED"
+	DisputeStateRejected DisputeState = "REJECTED"
+)
+
+var AllDisputeState = []DisputeState{
+	DisputeStatePending,
+	DisputeStateApproved,
+	DisputeStateRejected,
+}
+
+func (e DisputeState) IsValid() bool {
+	switch e {
+	case DisputeStatePending, DisputeStateApproved, DisputeStateRejected:
+		return true
+	}
+	return false
+}
+
+func (e DisputeState) String() string {
+	return string(e)
+}
+
+func (e *DisputeState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DisputeState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DisputeState", str)
+	}
+	return nil
+}
+
+func (e DisputeState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GameMode string
+
+const (
+	GameModeArea  GameMode = "AREA"
+	GameModeScore GameMode = "SCORE"
+)
+
+var AllGameMode = []GameMode{
+	GameModeArea,
+	GameModeScore,
+}
+
+func (e GameMode) IsValid() bool {
+	switch e {
+	case GameModeArea, GameModeScore:
+		return true
+	}
+	return false
+}
+
+func (e GameMode) String() string {
+	return string(e)
+}
+
+func (e *GameMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GameMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GameMode", str)
+	}
+	return nil
+}
+
+func (e GameMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TileModifier string
+
+const (
+	TileModifierPlain        TileModifier = "PLAIN"
+	TileModifierDoubleLetter TileModifier = "DOUBLE_LETTER"
+	TileModifierDoubleWord   TileModifier = "DOUBLE_WORD"
+	TileModifierLock         TileModifier = "LOCK"
+	TileModifierBomb         TileModifier = "BOMB"
+	TileModifierLocked       TileModifier = "LOCKED"
+)
+
+var AllTileModifier = []TileModifier{
+	TileModifierPlain,
+	TileModifierDoubleLetter,
+	TileModifierDoubleWord,
+	TileModifierLock,
+	TileModifierBomb,
+	TileModifierLocked,
+}
+
+func (e TileModifier) IsValid() bool {
+	switch e {
+	case TileModifierPlain, TileModifierDoubleLetter, TileModifierDoubleWord, TileModifierLock, TileModifierBomb, TileModifierLocked:
+		return true
+	}
+	return false
+}
+
+func (e TileModifier) String() string {
+	return string(e)
+}
+
+func (e *TileModifier) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TileModifier(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TileModifier", str)
+	}
+	return nil
+}
+
+func (e TileModifier) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
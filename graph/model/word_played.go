@@ -0,0 +1,8 @@
+package model
+
+// WordPlayed keeps the game language, the definition is looked up on its dictionary.
+type WordPlayed struct {
+	Player   *Player `json:"player"`
+	Word     string  `json:"word"`
+	Language string  `json:"-"`
+}
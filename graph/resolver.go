@@ -5,9 +5,11 @@ package graph
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 import (
+	"context"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/satriahrh/letter-block/data"
 	"github.com/satriahrh/letter-block/graph/model"
@@ -15,17 +17,34 @@ import (
 )
 
 const (
-	GAME_ID_BASE   = 36
-	PLAYER_ID_BASE = 36
+	GAME_ID_BASE      = 36
+	PLAYER_ID_BASE    = 36
+	DISPUTE_ID_BASE   = 36
+	WORD_LIST_ID_BASE = 36
 )
 
+var disputeStates = map[data.DisputeState]model.DisputeState{
+	data.PENDING:  model.DisputeStatePending,
+	data.APPROVED: model.DisputeStateApproved,
+	data.REJECTED: model.DisputeStateRejected,
+}
+
+var tileModifiers = map[data.TileModifier]model.TileModifier{
+	data.PLAIN:         model.TileModifierPlain,
+	data.DOUBLE_LETTER: model.TileModifierDoubleLetter,
+	data.DOUBLE_WORD:   model.TileModifierDoubleWord,
+	data.LOCK:          model.TileModifierLock,
+	data.BOMB:          model.TileModifierBomb,
+	data.LOCKED:        model.TileModifierLocked,
+}
+
 type Resolver struct {
 	application    service.Service
 	mutex          sync.Mutex
 	gameSubscriber map[data.GameId]map[data.PlayerId]GameSubscriber
 }
 
-type GameSubscriber chan *model.Game
+type GameSubscriber chan *model.MoveResult
 
 func NewResolver(svc service.Service) *Resolver {
 	return &Resolver{
@@ -35,6 +54,59 @@ func NewResolver(svc service.Service) *Resolver {
 	}
 }
 
+// VerifyPendingMoves verifies the pending moves on every interval until the context is done,
+// publishing the rolled back ones to the subscribers of their game.
+func (r *Resolver) VerifyPendingMoves(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rollbacks, err := r.application.VerifyPendingMoves(ctx)
+			if err != nil {
+				log.Println(err)
+			}
+			for _, rollback := range rollbacks {
+				r.publishMove(ctx, rollback.Game, rollback.Move)
+			}
+		}
+	}
+}
+
+// publishMove sends the move and the latest state of the game to its subscribers, skipping the ones not done
+// with the previous move so a slow subscriber holds nobody. The subscriptions last until their context is done,
+// not until the game ends, as an undo or a challenge may bring an ended game back.
+func (r *Resolver) publishMove(ctx context.Context, game data.Game, move data.Move) *model.MoveResult {
+	moveResult := serializeMove(game, move)
+
+	r.mutex.Lock()
+	subscribers := make([]GameSubscriber, 0, len(r.gameSubscriber[game.Id]))
+	for _, subscriber := range r.gameSubscriber[game.Id] {
+		subscribers = append(subscribers, subscriber)
+	}
+	r.mutex.Unlock()
+
+	if len(subscribers) == 0 {
+		return moveResult
+	}
+	fullGame, err := r.application.GetGame(ctx, game.Id)
+	if err != nil {
+		return moveResult
+	}
+	moveResult.Game = serializeGame(fullGame)
+	for _, subscriber := range subscribers {
+		select {
+		case subscriber <- moveResult:
+		default:
+			log.Printf("game %v subscriber is behind, skipping a move", game.Id)
+		}
+	}
+
+	return moveResult
+}
+
 func serializeGames(games []data.Game) []*model.Game {
 	serializedGames := make([]*model.Game, len(games))
 	for i, game := range games {
@@ -63,22 +135,211 @@ func serializeGame(game data.Game) *model.Game {
 			return boardPositioning
 		}(),
 		NumberOfPlayer: int(game.NumberOfPlayer),
-		WordPlayed:     serializeWordPlayeds(game.PlayedWords),
+		WordPlayed:     serializeWordPlayeds(game.PlayedWords, game.Settings.TileLanguage()),
 		Players:        serializePlayers(game.Players),
+		Settings:       serializeGameSettings(game.Settings),
+		Scores: func() []int {
+			scores := make([]int, game.NumberOfPlayer)
+			for i := 0; i < len(scores) && i < len(game.Scores); i++ {
+				scores[i] = int(game.Scores[i])
+			}
+			return scores
+		}(),
+		Tiles:       serializeTiles(game),
+		UndoRequest: serializeUndoRequest(game.PreviousState),
+		Ranking: func() []int {
+			ranking := game.Ranking()
+			serializedRanking := make([]int, len(ranking))
+			for i, playerOrder := range ranking {
+				serializedRanking[i] = int(playerOrder)
+			}
+			return serializedRanking
+		}(),
+	}
+}
+
+func serializeMove(game data.Game, move data.Move) *model.MoveResult {
+	moveResult := &model.MoveResult{
+		Positions:    serializePositions(move.Positions),
+		Claimed:      serializePositions(move.Claimed),
+		Captured:     []*model.Capture{},
+		Strengthened: serializePositions(move.Strengthened),
+		Weakened:     serializePositions(move.Weakened),
+		Reset:        serializePositions(move.Reset),
+		Drawn:        []string{},
+		Pending:      move.Pending,
+		RolledBack:   move.RolledBack,
+		Challenged:   move.Challenged,
+		Game:         serializeGame(game),
+	}
+	if move.PlayerId != 0 {
+		moveResult.Player = serializePlayer(data.Player{Id: move.PlayerId})
+		moveResult.Word = &move.Word
+	}
+
+	for order := uint8(0); int(order) < len(game.Players); order++ {
+		positions, ok := move.Captured[order]
+		if !ok {
+			continue
+		}
+		moveResult.Captured = append(moveResult.Captured, &model.Capture{
+			From:      serializePlayer(game.Players[order]),
+			Positions: serializePositions(positions),
+		})
+	}
+
+	letters, _ := data.Letters(game.Settings.TileLanguage())
+	for _, letterId := range move.Drawn {
+		if int(letterId) < len(letters) {
+			moveResult.Drawn = append(moveResult.Drawn, letters[letterId])
+		}
 	}
+
+	return moveResult
 }
 
-func serializeWordPlayeds(playedWords []data.PlayedWord) []*model.WordPlayed {
+func serializePositions(positions []uint8) []int {
+	serializedPositions := make([]int, len(positions))
+	for i, position := range positions {
+		serializedPositions[i] = int(position)
+	}
+	return serializedPositions
+}
+
+func serializeGameSettings(settings data.GameSettings) *model.GameSettings {
+	mode := model.GameModeArea
+	if settings.Mode == data.SCORE {
+		mode = model.GameModeScore
+	}
+	return &model.GameSettings{
+		Mode:             mode,
+		BonusTiles:       settings.BonusTiles,
+		Language:         settings.TileLanguage(),
+		HouseRules:       serializeHouseRules(settings.HouseRules),
+		ProvisionalTurns: settings.ProvisionalTurns,
+		Challenge:        settings.Challenge,
+	}
+}
+
+func serializeHouseRules(rules data.HouseRules) *model.HouseRules {
+	return &model.HouseRules{
+		AllowedWords: serializeWords(rules.AllowedWords),
+		BannedWords:  serializeWords(rules.BannedWords),
+		AllowedLists: serializeWordListIds(rules.AllowedLists),
+		BannedLists:  serializeWordListIds(rules.BannedLists),
+	}
+}
+
+func serializeWords(words []string) []string {
+	if words == nil {
+		return []string{}
+	}
+	return words
+}
+
+func serializeWordListIds(wordListIds []data.WordListId) []string {
+	serializedWordListIds := make([]string, len(wordListIds))
+	for i, wordListId := range wordListIds {
+		serializedWordListIds[i] = strconv.FormatUint(uint64(wordListId), WORD_LIST_ID_BASE)
+	}
+	return serializedWordListIds
+}
+
+func serializeWordLists(wordLists []data.WordList) []*model.WordList {
+	serializedWordLists := make([]*model.WordList, len(wordLists))
+	for i, wordList := range wordLists {
+		serializedWordLists[i] = serializeWordList(wordList)
+	}
+	return serializedWordLists
+}
+
+func serializeWordList(wordList data.WordList) *model.WordList {
+	return &model.WordList{
+		ID:    strconv.FormatUint(uint64(wordList.Id), WORD_LIST_ID_BASE),
+		Name:  wordList.Name,
+		Words: serializeWords(wordList.Words),
+	}
+}
+
+func serializeTiles(game data.Game) []*model.Tile {
+	letters, _ := data.Letters(game.Settings.TileLanguage())
+
+	serializedTiles := make([]*model.Tile, len(game.BoardBase))
+	for i, letterId := range game.BoardBase {
+		position := uint8(i)
+		modifier := data.PLAIN
+		if i < len(game.BoardModifiers) {
+			modifier = data.TileModifier(game.BoardModifiers[i])
+		}
+		tile := &model.Tile{
+			Position: i,
+			Modifier: tileModifiers[modifier],
+		}
+		if int(letterId) < len(letters) {
+			tile.Letter = letters[letterId]
+		}
+		if i < len(game.BoardPositioning) {
+			tile.Strength = int(game.TileStrength(position))
+			tile.Defended = game.TileDefended(position)
+			if owner := int(game.TileOwner(position)); owner > 0 && owner <= len(game.Players) {
+				tile.Owner = serializePlayer(game.Players[owner-1])
+			}
+		}
+		serializedTiles[i] = tile
+	}
+	return serializedTiles
+}
+
+func serializeUndoRequest(previousState data.GameSnapshot) *model.UndoRequest {
+	if !previousState.UndoRequested {
+		return nil
+	}
+	approvedBy := make([]data.Player, len(previousState.UndoApprovals))
+	for i, playerId := range previousState.UndoApprovals {
+		approvedBy[i] = data.Player{Id: playerId}
+	}
+	return &model.UndoRequest{
+		RequestedBy: serializePlayer(data.Player{Id: previousState.PlayerId}),
+		ApprovedBy:  serializePlayers(approvedBy),
+	}
+}
+
+func serializeWordPlayeds(playedWords []data.PlayedWord, language string) []*model.WordPlayed {
 	serializedWordPlayeds := make([]*model.WordPlayed, len(playedWords))
 	for i, playedWord := range playedWords {
 		serializedWordPlayeds[i] = &model.WordPlayed{
-			Player: serializePlayer(data.Player{Id: playedWord.PlayerId}),
-			Word:   playedWord.Word,
+			Player:   serializePlayer(data.Player{Id: playedWord.PlayerId}),
+			Word:     playedWord.Word,
+			Language: language,
 		}
 	}
 	return serializedWordPlayeds
 }
 
+func serializeEntry(entry data.Entry) *model.Word {
+	word := &model.Word{
+		Lemma:       entry.Lemma,
+		Definitions: entry.Definitions,
+	}
+	if word.Definitions == nil {
+		word.Definitions = []string{}
+	}
+	if entry.Class != "" {
+		word.Class = &entry.Class
+	}
+	return word
+}
+
+func serializeDispute(dispute data.Dispute) *model.Dispute {
+	return &model.Dispute{
+		ID:     strconv.FormatUint(uint64(dispute.Id), DISPUTE_ID_BASE),
+		Word:   dispute.Word,
+		Valid:  dispute.Valid,
+		Source: dispute.Source,
+		State:  disputeStates[dispute.State],
+	}
+}
+
 func serializePlayers(players []data.Player) []*model.Player {
 	serializedPlayers := make([]*model.Player, len(players))
 	for i, player := range players {
@@ -103,6 +364,45 @@ func parseGameId(rawGameId string) data.GameId {
 	return data.GameId(gameId)
 }
 
+func parseGameSettings(input model.NewGame) data.GameSettings {
+	var settings data.GameSettings
+	if input.Mode != nil && *input.Mode == model.GameModeScore {
+		settings.Mode = data.SCORE
+	}
+	if input.BonusTiles != nil {
+		settings.BonusTiles = *input.BonusTiles
+	}
+	if input.Language != nil {
+		settings.Language = *input.Language
+	}
+	settings.HouseRules = data.HouseRules{
+		AllowedWords: input.AllowedWords,
+		BannedWords:  input.BannedWords,
+		AllowedLists: parseWordListIds(input.AllowedLists),
+		BannedLists:  parseWordListIds(input.BannedLists),
+	}
+	if input.ProvisionalTurns != nil {
+		settings.ProvisionalTurns = *input.ProvisionalTurns
+	}
+	if input.Challenge != nil {
+		settings.Challenge = *input.Challenge
+	}
+	return settings
+}
+
+func parseWordListIds(rawWordListIds []string) []data.WordListId {
+	var wordListIds []data.WordListId
+	for _, rawWordListId := range rawWordListIds {
+		wordListId, err := strconv.ParseUint(rawWordListId, WORD_LIST_ID_BASE, 64)
+		if err != nil {
+			log.Println(err)
+		}
+		// an unparsable id is zero, which is never a saved list
+		wordListIds = append(wordListIds, data.WordListId(wordListId))
+	}
+	return wordListIds
+}
+
 func parseWord(rawWord []int) []uint8 {
 	word := make([]uint8, len(rawWord))
 	for i, w := range rawWord {
@@ -10,12 +10,13 @@ import (
 	"github.com/satriahrh/letter-block/graph/generated"
 	"github.com/satriahrh/letter-block/graph/model"
 	"github.com/satriahrh/letter-block/middleware/auth"
+	"github.com/satriahrh/letter-block/service"
 )
 
 func (r *mutationResolver) NewGame(ctx context.Context, input model.NewGame) (*model.Game, error) {
 	user := auth.ForContext(ctx)
 
-	game, err := r.application.NewGame(ctx, user.PlayerId, uint8(input.NumberOfPlayer))
+	game, err := r.application.NewGame(ctx, user.PlayerId, uint8(input.NumberOfPlayer), parseGameSettings(input))
 	if err != nil {
 		return nil, err
 	}
@@ -23,51 +24,92 @@ func (r *mutationResolver) NewGame(ctx context.Context, input model.NewGame) (*m
 	return serializeGame(game), nil
 }
 
-func (r *mutationResolver) TakeTurn(ctx context.Context, input model.TakeTurn) (*model.Game, error) {
+func (r *mutationResolver) TakeTurn(ctx context.Context, input model.TakeTurn) (*model.MoveResult, error) {
 	user := auth.ForContext(ctx)
 
 	gameId := parseGameId(input.GameID)
 	word := parseWord(input.Word)
 
-	game, err := r.application.TakeTurn(ctx, gameId, user.PlayerId, word)
+	game, move, err := r.application.TakeTurn(ctx, gameId, user.PlayerId, word)
 	if err != nil {
 		return nil, err
 	}
 
-	serializedGame := serializeGame(game)
+	return r.publishMove(ctx, game, move), nil
+}
 
-	r.mutex.Lock()
-	if len(r.gameSubscriber[gameId]) > 0 {
-		game, err = r.application.GetGame(ctx, gameId)
-		if err != nil {
-			r.mutex.Unlock()
-			return serializedGame, nil
-		}
-		serializedGame = serializeGame(game)
-	}
-	for _, subscriber := range r.gameSubscriber[gameId] {
-		subscriber <- serializedGame
+func (r *mutationResolver) JoinGame(ctx context.Context, input model.JoinGame) (*model.Game, error) {
+	user := auth.ForContext(ctx)
+
+	gameId := parseGameId(input.GameID)
+
+	game, err := r.application.JoinGame(ctx, gameId, user.PlayerId)
+	if err != nil {
+		return nil, err
 	}
-	r.mutex.Unlock()
 
-	if game.State != data.END {
-		delete(r.gameSubscriber, gameId)
+	return serializeGame(game), nil
+}
+
+func (r *mutationResolver) RequestUndo(ctx context.Context, gameID string) (*model.Game, error) {
+	user := auth.ForContext(ctx)
+
+	gameId := parseGameId(gameID)
+
+	game, err := r.application.RequestUndo(ctx, gameId, user.PlayerId)
+	if err != nil {
+		return nil, err
 	}
 
-	return serializedGame, nil
+	return r.publishMove(ctx, game, data.Move{}).Game, nil
 }
 
-func (r *mutationResolver) JoinGame(ctx context.Context, input model.JoinGame) (*model.Game, error) {
+func (r *mutationResolver) RespondUndo(ctx context.Context, input model.RespondUndo) (*model.Game, error) {
 	user := auth.ForContext(ctx)
 
 	gameId := parseGameId(input.GameID)
 
-	game, err := r.application.JoinGame(ctx, gameId, user.PlayerId)
+	game, err := r.application.RespondUndo(ctx, gameId, user.PlayerId, input.Accept)
 	if err != nil {
 		return nil, err
 	}
 
-	return serializeGame(game), nil
+	return r.publishMove(ctx, game, data.Move{}).Game, nil
+}
+
+func (r *mutationResolver) ChallengeWord(ctx context.Context, gameID string) (*model.MoveResult, error) {
+	user := auth.ForContext(ctx)
+
+	gameId := parseGameId(gameID)
+
+	game, move, err := r.application.ChallengeWord(ctx, gameId, user.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.publishMove(ctx, game, move), nil
+}
+
+func (r *mutationResolver) DisputeWord(ctx context.Context, gameID string, word string) (*model.Dispute, error) {
+	user := auth.ForContext(ctx)
+
+	dispute, err := r.application.DisputeWord(ctx, parseGameId(gameID), user.PlayerId, word)
+	if err != nil {
+		return nil, err
+	}
+
+	return serializeDispute(dispute), nil
+}
+
+func (r *mutationResolver) SaveWordList(ctx context.Context, input model.NewWordList) (*model.WordList, error) {
+	user := auth.ForContext(ctx)
+
+	wordList, err := r.application.SaveWordList(ctx, user.PlayerId, input.Name, input.Words)
+	if err != nil {
+		return nil, err
+	}
+
+	return serializeWordList(wordList), nil
 }
 
 func (r *queryResolver) MyGames(ctx context.Context) ([]*model.Game, error) {
@@ -103,7 +145,18 @@ func (r *queryResolver) Me(ctx context.Context) (*model.Player, error) {
 	return serializePlayer(player), nil
 }
 
-func (r *subscriptionResolver) ListenGame(ctx context.Context, gameID string) (<-chan *model.Game, error) {
+func (r *queryResolver) MyWordLists(ctx context.Context) ([]*model.WordList, error) {
+	user := auth.ForContext(ctx)
+
+	wordLists, err := r.application.GetWordLists(ctx, user.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return serializeWordLists(wordLists), nil
+}
+
+func (r *subscriptionResolver) ListenGame(ctx context.Context, gameID string) (<-chan *model.MoveResult, error) {
 	user := auth.ForContext(ctx)
 
 	gameId := parseGameId(gameID)
@@ -111,24 +164,39 @@ func (r *subscriptionResolver) ListenGame(ctx context.Context, gameID string) (<
 		return nil, err
 	}
 
+	gameSubscriber := make(GameSubscriber, 1)
 	r.mutex.Lock()
-	{
-		gameSubscriber := make(GameSubscriber, 1)
-		if r.gameSubscriber[gameId] == nil {
-			r.gameSubscriber[gameId] = make(map[data.PlayerId]GameSubscriber)
-		}
-		r.gameSubscriber[gameId][user.PlayerId] = gameSubscriber
+	if r.gameSubscriber[gameId] == nil {
+		r.gameSubscriber[gameId] = make(map[data.PlayerId]GameSubscriber)
 	}
+	r.gameSubscriber[gameId][user.PlayerId] = gameSubscriber
 	r.mutex.Unlock()
 
 	go func() {
 		<-ctx.Done()
 		r.mutex.Lock()
-		delete(r.gameSubscriber[gameId], user.PlayerId)
+		// unless the player subscribed again meanwhile
+		if r.gameSubscriber[gameId][user.PlayerId] == gameSubscriber {
+			delete(r.gameSubscriber[gameId], user.PlayerId)
+		}
+		if len(r.gameSubscriber[gameId]) == 0 {
+			delete(r.gameSubscriber, gameId)
+		}
 		r.mutex.Unlock()
 	}()
 
-	return r.gameSubscriber[gameId][user.PlayerId], nil
+	return gameSubscriber, nil
+}
+
+func (r *wordPlayedResolver) Definition(ctx context.Context, obj *model.WordPlayed) (*model.Word, error) {
+	entry, err := r.application.DefineWord(ctx, obj.Language, obj.Word)
+	if err == service.ErrorNoDefinition {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return serializeEntry(entry), nil
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -140,6 +208,10 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
+// WordPlayed returns generated.WordPlayedResolver implementation.
+func (r *Resolver) WordPlayed() generated.WordPlayedResolver { return &wordPlayedResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type wordPlayedResolver struct{ *Resolver }
@@ -0,0 +1,197 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/satriahrh/letter-block/data"
+	data_dictionary "github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/satriahrh/letter-block/dictionary/id_id"
+	"github.com/satriahrh/letter-block/service"
+)
+
+// DictionaryCache is satisfied by data/dictionary.Dictionary
+type DictionaryCache interface {
+	Override(lang, key string, value bool) error
+	Invalidate(lang, key string) error
+	Stats() data_dictionary.Stats
+}
+
+// Moderation is satisfied by service.Service
+type Moderation interface {
+	GetDisputes(ctx context.Context, state data.DisputeState) ([]data.Dispute, error)
+	DecideDispute(ctx context.Context, disputeId data.DisputeId, approve bool, moderator string) (data.Dispute, error)
+}
+
+type Admin struct {
+	token      string
+	dictionary DictionaryCache
+	moderation Moderation
+}
+
+// New guards the admin operations with the token, an empty token disables them.
+func New(token string, dictionary DictionaryCache, moderation Moderation) *Admin {
+	return &Admin{token, dictionary, moderation}
+}
+
+// HttpMiddleware will authorize the admin token, given as "Bearer <token>"
+func (a *Admin) HttpMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if a.token == "" || !strings.HasPrefix(authorization, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(authorization[7:]), []byte(a.token)) != 1 {
+			errorResponse(w, http.StatusForbidden, "invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *Admin) DictionaryStats(w http.ResponseWriter, r *http.Request) {
+	successResponse(w, a.dictionary.Stats())
+}
+
+// DictionaryOverride replaces the cached verdict of lang and word by valid, on every cache language of lang
+func (a *Admin) DictionaryOverride(w http.ResponseWriter, r *http.Request) {
+	lang, word, ok := parseWord(w, r)
+	if !ok {
+		return
+	}
+	valid, err := strconv.ParseBool(r.Form.Get("valid"))
+	if err != nil {
+		errorResponse(w, http.StatusUnprocessableEntity, "valid should be true or false")
+		return
+	}
+
+	for _, cacheLanguage := range id_id.CacheLanguages(lang) {
+		err = a.dictionary.Override(cacheLanguage, word, valid)
+		if err != nil {
+			log.Println(err)
+			errorResponse(w, http.StatusInternalServerError, "cannot override")
+			return
+		}
+	}
+	log.Printf("admin overrode %v %v as valid: %v", lang, word, valid)
+	successResponse(w, "success")
+}
+
+// DictionaryInvalidate forgets the cached verdict of lang and word, on every cache language of lang
+func (a *Admin) DictionaryInvalidate(w http.ResponseWriter, r *http.Request) {
+	lang, word, ok := parseWord(w, r)
+	if !ok {
+		return
+	}
+
+	for _, cacheLanguage := range id_id.CacheLanguages(lang) {
+		err := a.dictionary.Invalidate(cacheLanguage, word)
+		if err != nil {
+			log.Println(err)
+			errorResponse(w, http.StatusInternalServerError, "cannot invalidate")
+			return
+		}
+	}
+	log.Printf("admin invalidated %v %v", lang, word)
+	successResponse(w, "success")
+}
+
+var disputeStates = map[string]data.DisputeState{
+	"":         data.PENDING,
+	"pending":  data.PENDING,
+	"approved": data.APPROVED,
+	"rejected": data.REJECTED,
+}
+
+// Disputes lists the disputes on the state query, pending by default as the moderation queue
+func (a *Admin) Disputes(w http.ResponseWriter, r *http.Request) {
+	state, ok := disputeStates[r.URL.Query().Get("state")]
+	if !ok {
+		errorResponse(w, http.StatusUnprocessableEntity, "state should be pending, approved or rejected")
+		return
+	}
+
+	disputes, err := a.moderation.GetDisputes(r.Context(), state)
+	if err != nil {
+		log.Println(err)
+		errorResponse(w, http.StatusInternalServerError, "cannot list disputes")
+		return
+	}
+	if disputes == nil {
+		disputes = []data.Dispute{}
+	}
+	successResponse(w, disputes)
+}
+
+// DisputeDecide approves or rejects the dispute of id, an approved dispute overrides the disputed verdict
+func (a *Admin) DisputeDecide(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		errorResponse(w, http.StatusUnprocessableEntity, "cannot parse form")
+		return
+	}
+	disputeId, err := strconv.ParseUint(r.Form.Get("id"), 10, 64)
+	if err != nil {
+		errorResponse(w, http.StatusUnprocessableEntity, "id should be a dispute id")
+		return
+	}
+	approve, err := strconv.ParseBool(r.Form.Get("approve"))
+	if err != nil {
+		errorResponse(w, http.StatusUnprocessableEntity, "approve should be true or false")
+		return
+	}
+	moderator := strings.TrimSpace(r.Form.Get("moderator"))
+	if moderator == "" {
+		errorResponse(w, http.StatusUnprocessableEntity, "moderator is required")
+		return
+	}
+
+	dispute, err := a.moderation.DecideDispute(r.Context(), data.DisputeId(disputeId), approve, moderator)
+	switch err {
+	case nil:
+		successResponse(w, dispute)
+	case service.ErrorDisputeNotFound:
+		errorResponse(w, http.StatusNotFound, err.Error())
+	case service.ErrorDisputeDecided:
+		errorResponse(w, http.StatusConflict, err.Error())
+	default:
+		log.Println(err)
+		errorResponse(w, http.StatusInternalServerError, "cannot decide dispute")
+	}
+}
+
+func parseWord(w http.ResponseWriter, r *http.Request) (lang, word string, ok bool) {
+	if err := r.ParseForm(); err != nil {
+		errorResponse(w, http.StatusUnprocessableEntity, "cannot parse form")
+		return
+	}
+	lang = r.Form.Get("lang")
+	word = strings.ToLower(strings.TrimSpace(r.Form.Get("word")))
+	if lang == "" || word == "" {
+		errorResponse(w, http.StatusUnprocessableEntity, "lang and word are required")
+		return
+	}
+	return lang, word, true
+}
+
+func errorResponse(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(
+		struct {
+			Message string `json:"message"`
+		}{message},
+	)
+}
+
+func successResponse(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_ = json.NewEncoder(w).Encode(
+		struct {
+			Data interface{} `json:"data"`
+		}{data},
+	)
+}
@@ -0,0 +1,235 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	data_dictionary "github.com/satriahrh/letter-block/data/dictionary"
+	"github.com/satriahrh/letter-block/middleware/admin"
+	"github.com/satriahrh/letter-block/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DictionaryCache struct {
+	mock.Mock
+}
+
+func (d *DictionaryCache) Override(lang, key string, value bool) error {
+	return d.Called(lang, key, value).Error(0)
+}
+
+func (d *DictionaryCache) Invalidate(lang, key string) error {
+	return d.Called(lang, key).Error(0)
+}
+
+func (d *DictionaryCache) Stats() data_dictionary.Stats {
+	return d.Called().Get(0).(data_dictionary.Stats)
+}
+
+type Moderation struct {
+	mock.Mock
+}
+
+func (m *Moderation) GetDisputes(ctx context.Context, state data.DisputeState) ([]data.Dispute, error) {
+	args := m.Called(state)
+	return args.Get(0).([]data.Dispute), args.Error(1)
+}
+
+func (m *Moderation) DecideDispute(ctx context.Context, disputeId data.DisputeId, approve bool, moderator string) (data.Dispute, error) {
+	args := m.Called(disputeId, approve, moderator)
+	return args.Get(0).(data.Dispute), args.Error(1)
+}
+
+func request(form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestAdmin_HttpMiddleware(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	testSuite := func(token, authorization string, expectedCode int) func(t *testing.T) {
+		return func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Authorization", authorization)
+			w := httptest.NewRecorder()
+
+			admin.New(token, &DictionaryCache{}, &Moderation{}).HttpMiddleware(ok).ServeHTTP(w, r)
+			assert.Equal(t, expectedCode, w.Code)
+		}
+	}
+
+	t.Run("Authorized", testSuite("secret", "Bearer secret", http.StatusOK))
+	t.Run("WrongToken", testSuite("secret", "Bearer guess", http.StatusForbidden))
+	t.Run("NoToken", testSuite("secret", "", http.StatusForbidden))
+	t.Run("Disabled", testSuite("", "Bearer ", http.StatusForbidden))
+}
+
+func TestAdmin_DictionaryStats(t *testing.T) {
+	dict := &DictionaryCache{}
+	dict.On("Stats").Return(data_dictionary.Stats{LocalHits: 1, RemoteHits: 2, StoreHits: 4, Misses: 3})
+
+	w := httptest.NewRecorder()
+	admin.New("secret", dict, &Moderation{}).DictionaryStats(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"local_hits":1,"remote_hits":2,"store_hits":4,"misses":3}}`, w.Body.String())
+}
+
+func TestAdmin_DictionaryOverride(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dict := &DictionaryCache{}
+		dict.On("Override", "id-id", "word", true).Return(nil)
+		dict.On("Override", "id-id.root", "word", true).Return(nil)
+
+		w := httptest.NewRecorder()
+		admin.New("secret", dict, &Moderation{}).DictionaryOverride(w, request(url.Values{
+			"lang": {"id-id"}, "word": {" Word "}, "valid": {"true"},
+		}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		dict.AssertExpectations(t)
+	})
+	t.Run("OtherLanguage", func(t *testing.T) {
+		dict := &DictionaryCache{}
+		dict.On("Override", "en-us", "word", false).Return(nil)
+
+		w := httptest.NewRecorder()
+		admin.New("secret", dict, &Moderation{}).DictionaryOverride(w, request(url.Values{
+			"lang": {"en-us"}, "word": {"word"}, "valid": {"false"},
+		}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		dict.AssertExpectations(t)
+	})
+	t.Run("ErrorMissingWord", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		admin.New("secret", &DictionaryCache{}, &Moderation{}).DictionaryOverride(w, request(url.Values{
+			"lang": {"id-id"}, "valid": {"true"},
+		}))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+	t.Run("ErrorInvalidValid", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		admin.New("secret", &DictionaryCache{}, &Moderation{}).DictionaryOverride(w, request(url.Values{
+			"lang": {"id-id"}, "word": {"word"}, "valid": {"maybe"},
+		}))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+	t.Run("ErrorOverride", func(t *testing.T) {
+		dict := &DictionaryCache{}
+		dict.On("Override", "id-id", "word", false).Return(errors.New("unexpected error"))
+
+		w := httptest.NewRecorder()
+		admin.New("secret", dict, &Moderation{}).DictionaryOverride(w, request(url.Values{
+			"lang": {"id-id"}, "word": {"word"}, "valid": {"false"},
+		}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAdmin_DictionaryInvalidate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dict := &DictionaryCache{}
+		dict.On("Invalidate", "id-id", "word").Return(nil)
+		dict.On("Invalidate", "id-id.root", "word").Return(nil)
+
+		w := httptest.NewRecorder()
+		admin.New("secret", dict, &Moderation{}).DictionaryInvalidate(w, request(url.Values{
+			"lang": {"id-id"}, "word": {"word"},
+		}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		dict.AssertExpectations(t)
+	})
+	t.Run("ErrorInvalidate", func(t *testing.T) {
+		dict := &DictionaryCache{}
+		dict.On("Invalidate", "id-id", "word").Return(errors.New("unexpected error"))
+
+		w := httptest.NewRecorder()
+		admin.New("secret", dict, &Moderation{}).DictionaryInvalidate(w, request(url.Values{
+			"lang": {"id-id"}, "word": {"word"},
+		}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAdmin_Disputes(t *testing.T) {
+	t.Run("Pending", func(t *testing.T) {
+		moderation := &Moderation{}
+		moderation.On("GetDisputes", data.PENDING).
+			Return([]data.Dispute(nil), nil)
+
+		w := httptest.NewRecorder()
+		admin.New("secret", &DictionaryCache{}, moderation).Disputes(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":[]}`, w.Body.String())
+	})
+	t.Run("ErrorState", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		admin.New("secret", &DictionaryCache{}, &Moderation{}).Disputes(w, httptest.NewRequest(http.MethodGet, "/?state=open", nil))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+	t.Run("ErrorGetDisputes", func(t *testing.T) {
+		moderation := &Moderation{}
+		moderation.On("GetDisputes", data.APPROVED).
+			Return([]data.Dispute(nil), errors.New("unexpected error"))
+
+		w := httptest.NewRecorder()
+		admin.New("secret", &DictionaryCache{}, moderation).Disputes(w, httptest.NewRequest(http.MethodGet, "/?state=approved", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAdmin_DisputeDecide(t *testing.T) {
+	form := url.Values{"id": {"7"}, "approve": {"true"}, "moderator": {"satria"}}
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"ErrorDisputeNotFound", service.ErrorDisputeNotFound, http.StatusNotFound},
+		{"ErrorDisputeDecided", service.ErrorDisputeDecided, http.StatusConflict},
+		{"ErrorUnexpected", errors.New("unexpected error"), http.StatusInternalServerError},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			moderation := &Moderation{}
+			moderation.On("DecideDispute", data.DisputeId(7), true, "satria").
+				Return(data.Dispute{Id: 7, State: data.APPROVED}, tc.err)
+
+			w := httptest.NewRecorder()
+			admin.New("secret", &DictionaryCache{}, moderation).DisputeDecide(w, request(form))
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+		})
+	}
+	t.Run("ErrorForm", func(t *testing.T) {
+		for _, invalid := range []url.Values{
+			{"approve": {"true"}, "moderator": {"satria"}},
+			{"id": {"7"}, "approve": {"maybe"}, "moderator": {"satria"}},
+			{"id": {"7"}, "approve": {"true"}},
+		} {
+			w := httptest.NewRecorder()
+			admin.New("secret", &DictionaryCache{}, &Moderation{}).DisputeDecide(w, request(invalid))
+
+			assert.Equal(t, http.StatusUnprocessableEntity, w.Code, invalid.Encode())
+		}
+	})
+}
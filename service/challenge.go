@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+// ChallengeWord checks the word of the last move of a challenge game against the dictionary, on behalf of the player
+// whose turn follows it. An invalid word is rolled back and its player loses their turn, otherwise the challenger does.
+func (a *application) ChallengeWord(ctx context.Context, gameId data.GameId, playerId data.PlayerId) (game data.Game, move data.Move, err error) {
+	tx, err := a.transactional.BeginTransaction(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = a.transactional.FinalizeTransaction(tx, err)
+	}()
+
+	game, err = a.transactional.GetGameById(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+
+	// only the last move could be challenged, once, by another player
+	challenged := game.PreviousState
+	if !game.Settings.Challenge || !lastMove(game) || challenged.PlayerId == playerId {
+		err = ErrorChallengeNotAllowed
+		return
+	}
+	if game.State != data.ONGOING && game.State != data.END {
+		err = ErrorGameIsUnplayable
+		return
+	}
+
+	gamePlayers, err := a.transactional.GetGamePlayersByGameId(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+
+	// the challenge is made before moving, on the turn following the challenged move
+	if uint8(len(gamePlayers))-1 < game.CurrentPlayerOrder {
+		err = ErrorNotYourTurn
+		return
+	} else if gamePlayers[game.CurrentPlayerOrder].PlayerId != playerId {
+		err = ErrorNotYourTurn
+		return
+	}
+
+	game.Players = []data.Player{}
+	for _, gamePlayer := range gamePlayers {
+		game.Players = append(game.Players, data.Player{Id: gamePlayer.PlayerId})
+	}
+
+	valid, decided := game.Settings.HouseRules.Verdict(challenged.Word)
+	if !decided {
+		dictionaryLanguage, _ := data.DictionaryLanguage(game.Settings.TileLanguage())
+		dict, ok := a.dictionaries[dictionaryLanguage]
+		if !ok {
+			err = ErrorDictionaryUnavailable
+			return
+		}
+		valid, err = dict.LemmaIsValid(ctx, challenged.Word)
+		if err != nil {
+			err = dictionaryError(ctx, err)
+			return
+		}
+	}
+
+	move = data.Move{
+		PlayerId:   challenged.PlayerId,
+		Word:       challenged.Word,
+		Challenged: true,
+		RolledBack: !valid,
+	}
+
+	if valid {
+		// the word stood the challenge, it is neither challenged again nor undone
+		game.PreviousState = data.GameSnapshot{}
+	} else {
+		err = a.transactional.DeletePlayedWord(ctx, tx, gameId, challenged.Word)
+		if err != nil {
+			return
+		}
+		forgetPendingMove(&game)
+		game.Restore(challenged)
+	}
+
+	// the turn passes on from whom lost the challenge
+	if game.State == data.ONGOING {
+		game.CurrentPlayerOrder += 1
+		if game.CurrentPlayerOrder >= game.NumberOfPlayer {
+			game.CurrentPlayerOrder = 0
+		}
+	}
+
+	err = a.transactional.UpdateGame(ctx, tx, game)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,123 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplication_ChallengeWord(t *testing.T) {
+	previousState := data.GameSnapshot{
+		PlayerId: players[0].Id, Word: "kata",
+		CurrentPlayerOrder: 0, State: data.ONGOING,
+		BoardPositioning: make([]uint8, 25), BoardBase: boardBaseFresh(), LetterBank: letterBank,
+	}
+	challengeSuite := func(t *testing.T, game data.Game, challenger data.PlayerId, valid bool, dictionaryError, expectedError error) (*Transactional, data.Game, data.Move, error) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(game, nil)
+		trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+			Return(gamePlayers, nil)
+		trans.On("DeletePlayedWord", ctx, tx, gameId, "kata").
+			Return(nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, expectedError).
+			Return(nil)
+
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "kata").
+			Return(valid, dictionaryError)
+
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		game, move, err := svc.ChallengeWord(ctx, gameId, challenger)
+		return trans, game, move, err
+	}
+	challengeGame := func() data.Game {
+		return data.Game{
+			CurrentPlayerOrder: 1, NumberOfPlayer: 2, State: data.ONGOING,
+			BoardPositioning: []uint8{1, 1, 0, 0}, PreviousState: previousState,
+			Settings: data.GameSettings{Challenge: true},
+		}
+	}
+
+	t.Run("ErrorChallengeNotAllowed", func(t *testing.T) {
+		t.Run("NotChallengeMode", func(t *testing.T) {
+			game := challengeGame()
+			game.Settings.Challenge = false
+			_, _, _, err := challengeSuite(t, game, players[1].Id, false, nil, service.ErrorChallengeNotAllowed)
+			assert.EqualError(t, err, service.ErrorChallengeNotAllowed.Error())
+		})
+		t.Run("NothingToChallenge", func(t *testing.T) {
+			game := challengeGame()
+			game.PreviousState = data.GameSnapshot{}
+			_, _, _, err := challengeSuite(t, game, players[1].Id, false, nil, service.ErrorChallengeNotAllowed)
+			assert.EqualError(t, err, service.ErrorChallengeNotAllowed.Error())
+		})
+		t.Run("OwnMove", func(t *testing.T) {
+			_, _, _, err := challengeSuite(t, challengeGame(), players[0].Id, false, nil, service.ErrorChallengeNotAllowed)
+			assert.EqualError(t, err, service.ErrorChallengeNotAllowed.Error())
+		})
+		t.Run("NotLastMove", func(t *testing.T) {
+			game := challengeGame()
+			game.PreviousState.CurrentPlayerOrder = 1
+			_, _, _, err := challengeSuite(t, game, players[1].Id, false, nil, service.ErrorChallengeNotAllowed)
+			assert.EqualError(t, err, service.ErrorChallengeNotAllowed.Error())
+		})
+	})
+	t.Run("ErrorNotYourTurn", func(t *testing.T) {
+		_, _, _, err := challengeSuite(t, challengeGame(), players[1].Id+1, false, nil, service.ErrorNotYourTurn)
+		assert.EqualError(t, err, service.ErrorNotYourTurn.Error())
+	})
+	t.Run("ErrorDictionaryUnavailable", func(t *testing.T) {
+		trans, _, _, err := challengeSuite(t, challengeGame(), players[1].Id, false, dictionary.ErrorProviderUnavailable, service.ErrorDictionaryUnavailable)
+		assert.EqualError(t, err, service.ErrorDictionaryUnavailable.Error())
+		trans.AssertNotCalled(t, "UpdateGame")
+	})
+	t.Run("Succeeded", func(t *testing.T) {
+		trans, game, move, err := challengeSuite(t, challengeGame(), players[1].Id, false, nil, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.Move{PlayerId: players[0].Id, Word: "kata", Challenged: true, RolledBack: true}, move)
+			assert.Equal(t, data.GameSnapshot{}, game.PreviousState)
+			assert.Equal(t, make([]uint8, 25), game.BoardPositioning)
+			assert.Equal(t, uint8(1), game.CurrentPlayerOrder, "the challenged player loses their turn")
+			trans.AssertCalled(t, "DeletePlayedWord", ctx, tx, gameId, "kata")
+		}
+	})
+	t.Run("SucceededOnEndingMove", func(t *testing.T) {
+		game := challengeGame()
+		game.State = data.END
+		_, game, _, err := challengeSuite(t, game, players[1].Id, false, nil, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.ONGOING, game.State)
+			assert.Equal(t, uint8(1), game.CurrentPlayerOrder)
+		}
+	})
+	t.Run("FailedByHouseRules", func(t *testing.T) {
+		game := challengeGame()
+		game.Settings.HouseRules = data.HouseRules{AllowedWords: []string{"kata"}}
+		trans, game, move, err := challengeSuite(t, game, players[1].Id, false, nil, nil)
+		if assert.NoError(t, err) {
+			assert.False(t, move.RolledBack, "allowed regardless of the dictionary")
+			assert.Equal(t, data.GameSnapshot{}, game.PreviousState)
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "kata")
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		trans, game, move, err := challengeSuite(t, challengeGame(), players[1].Id, true, nil, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.Move{PlayerId: players[0].Id, Word: "kata", Challenged: true}, move)
+			assert.Equal(t, data.GameSnapshot{}, game.PreviousState, "neither challenged again nor undone")
+			assert.Equal(t, []uint8{1, 1, 0, 0}, game.BoardPositioning)
+			assert.Equal(t, uint8(0), game.CurrentPlayerOrder, "the challenger loses their turn")
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "kata")
+		}
+	})
+}
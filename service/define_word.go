@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// DefineWord looks the word up on the dictionary of the tiles language.
+func (a *application) DefineWord(ctx context.Context, language, word string) (entry data.Entry, err error) {
+	dictionaryLanguage, err := data.DictionaryLanguage(language)
+	if err != nil {
+		err = ErrorNoDefinition
+		return
+	}
+	definer, ok := a.dictionaries[dictionaryLanguage].(dictionary.Definer)
+	if !ok {
+		err = ErrorNoDefinition
+		return
+	}
+
+	entry, err = definer.Define(ctx, word)
+	if err == dictionary.ErrorNotFound {
+		err = ErrorNoDefinition
+	} else if err != nil {
+		err = dictionaryError(ctx, err)
+	}
+	return
+}
@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplication_DefineWord(t *testing.T) {
+	entry := data.Entry{Lemma: "word", Class: "Nomina", Definitions: []string{"kata"}}
+
+	t.Run("Success", func(t *testing.T) {
+		dict := &Definer{}
+		dict.On("Define", "word").Return(entry, nil)
+
+		svc := service.NewService(&Transactional{}, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		result, err := svc.DefineWord(ctx, "id", "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, entry, result)
+		}
+	})
+	t.Run("ErrorNoDefinition", func(t *testing.T) {
+		t.Run("UnknownLanguage", func(t *testing.T) {
+			svc := service.NewService(&Transactional{}, map[string]dictionary.Dictionary{})
+			_, err := svc.DefineWord(ctx, "--", "word")
+			assert.EqualError(t, err, service.ErrorNoDefinition.Error())
+		})
+		t.Run("NotDefiner", func(t *testing.T) {
+			svc := service.NewService(&Transactional{}, map[string]dictionary.Dictionary{
+				"id-id": &Dictionary{},
+			})
+			_, err := svc.DefineWord(ctx, "id", "word")
+			assert.EqualError(t, err, service.ErrorNoDefinition.Error())
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			dict := &Definer{}
+			dict.On("Define", "word").Return(data.Entry{}, dictionary.ErrorNotFound)
+
+			svc := service.NewService(&Transactional{}, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			_, err := svc.DefineWord(ctx, "id", "word")
+			assert.EqualError(t, err, service.ErrorNoDefinition.Error())
+		})
+	})
+	t.Run("ErrorDictionaryUnavailable", func(t *testing.T) {
+		dict := &Definer{}
+		dict.On("Define", "word").Return(data.Entry{}, errors.New("unexpected error"))
+
+		svc := service.NewService(&Transactional{}, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		_, err := svc.DefineWord(ctx, "id", "word")
+		assert.EqualError(t, err, service.ErrorDictionaryUnavailable.Error())
+	})
+}
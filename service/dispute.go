@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+)
+
+// DisputeWord records the appeal of a player against the current dictionary verdict of the word.
+func (a *application) DisputeWord(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word string) (dispute data.Dispute, err error) {
+	word = data.FoldWord(strings.TrimSpace(word))
+	if word == "" {
+		err = ErrorWordInvalid
+		return
+	}
+
+	game, err := a.transactional.GetGameById(ctx, nil, gameId)
+	if err != nil {
+		return
+	}
+	players, err := a.transactional.GetPlayersByGameId(ctx, gameId)
+	if err != nil {
+		return
+	}
+	joined := false
+	for _, player := range players {
+		joined = joined || player.Id == playerId
+	}
+	if !joined {
+		err = ErrorUnauthorized
+		return
+	}
+
+	// rejected words are not recorded, so a word is either played or could be made of the board letters
+	playedWords, err := a.transactional.GetPlayedWordsByGameId(ctx, gameId)
+	if err != nil {
+		return
+	}
+	played := false
+	for _, playedWord := range playedWords {
+		played = played || playedWord.Word == word
+	}
+	if !played && !onBoard(game, word) {
+		err = ErrorWordNotDisputable
+		return
+	}
+
+	language, _ := data.DictionaryLanguage(game.Settings.TileLanguage())
+	// one appeal of the word awaits the moderators at a time
+	pending, exist, err := a.transactional.GetPendingDispute(ctx, language, word)
+	if err != nil || exist {
+		return pending, err
+	}
+
+	dict, ok := a.dictionaries[language]
+	if !ok {
+		err = ErrorDictionaryUnavailable
+		return
+	}
+	verdict := dictionary.Verdict{Source: language}
+	if arbiter, ok := dict.(dictionary.Arbiter); ok {
+		verdict, err = arbiter.Verdict(ctx, word)
+	} else {
+		verdict.Valid, err = dict.LemmaIsValid(ctx, word)
+	}
+	if err != nil {
+		err = dictionaryError(ctx, err)
+		return
+	}
+
+	return a.transactional.InsertDispute(ctx, data.Dispute{
+		GameId:    gameId,
+		PlayerId:  playerId,
+		Language:  language,
+		Word:      word,
+		Valid:     verdict.Valid,
+		Source:    verdict.Source,
+		State:     data.PENDING,
+		CreatedAt: time.Now().Unix(),
+	})
+}
+
+// onBoard tells whether the letters on the board of the game make the word.
+func onBoard(game data.Game, word string) bool {
+	letters, _ := data.Letters(game.Settings.TileLanguage())
+	available := make(map[string]int)
+	for _, letterId := range game.BoardBase {
+		if letterId != 0 && int(letterId) < len(letters) {
+			available[letters[letterId]]++
+		}
+	}
+	return spell(word, available)
+}
+
+// spell tries every available letter the word starts with, as a letter may be more than one character like ng.
+func spell(word string, available map[string]int) bool {
+	if word == "" {
+		return true
+	}
+	for letter, count := range available {
+		if count == 0 || !strings.HasPrefix(word, letter) {
+			continue
+		}
+		available[letter]--
+		spelled := spell(word[len(letter):], available)
+		available[letter]++
+		if spelled {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDisputes lists the disputes on the state, the pending ones are the moderation queue.
+func (a *application) GetDisputes(ctx context.Context, state data.DisputeState) ([]data.Dispute, error) {
+	return a.transactional.GetDisputesByState(ctx, state)
+}
+
+// DecideDispute approves or rejects a pending dispute. Approving overrides the disputed verdict for every game.
+func (a *application) DecideDispute(ctx context.Context, disputeId data.DisputeId, approve bool, moderator string) (dispute data.Dispute, err error) {
+	tx, err := a.transactional.BeginTransaction(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = a.transactional.FinalizeTransaction(tx, err)
+	}()
+
+	dispute, err = a.transactional.GetDisputeById(ctx, tx, disputeId)
+	if err == sql.ErrNoRows {
+		err = ErrorDisputeNotFound
+		return
+	} else if err != nil {
+		return
+	}
+	if dispute.State != data.PENDING {
+		err = ErrorDisputeDecided
+		return
+	}
+
+	dispute.State = data.REJECTED
+	if approve {
+		dispute.State = data.APPROVED
+	}
+	dispute.Moderator = moderator
+	dispute.DecidedAt = time.Now().Unix()
+	err = a.transactional.UpdateDispute(ctx, tx, dispute)
+	if err != nil {
+		return
+	}
+
+	if approve {
+		err = a.transactional.UpsertWordOverride(ctx, tx, data.WordOverride{
+			Language:  dispute.Language,
+			Word:      dispute.Word,
+			Valid:     !dispute.Valid,
+			DisputeId: dispute.Id,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	log.Printf("dispute %v on %v %v decided by %v, approved: %v", dispute.Id, dispute.Language, dispute.Word, moderator, approve)
+	return
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplication_DisputeWord(t *testing.T) {
+	testSuite := func() *Transactional {
+		trans := &Transactional{}
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{}, nil)
+		trans.On("GetPlayersByGameId", ctx, gameId).
+			Return(players, nil)
+		trans.On("GetPlayedWordsByGameId", ctx, gameId).
+			Return([]data.PlayedWord{{PlayerId: players[1].Id, Word: "word"}}, nil)
+		trans.On("GetPendingDispute", ctx, "id-id", mock.Anything).
+			Return(data.Dispute{}, false, nil)
+		return trans
+	}
+
+	t.Run("ErrorUnauthorized", func(t *testing.T) {
+		trans := testSuite()
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{})
+
+		_, err := svc.DisputeWord(ctx, gameId, data.PlayerId(1), "word")
+		assert.EqualError(t, err, service.ErrorUnauthorized.Error())
+	})
+	t.Run("ErrorWordNotDisputable", func(t *testing.T) {
+		trans := testSuite()
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{"id-id": &Arbiter{}})
+
+		_, err := svc.DisputeWord(ctx, gameId, playerId, "kata")
+		assert.EqualError(t, err, service.ErrorWordNotDisputable.Error())
+		trans.AssertNotCalled(t, "InsertDispute", mock.Anything, mock.Anything)
+	})
+	t.Run("ErrorDictionaryUnavailable", func(t *testing.T) {
+		trans := testSuite()
+		dict := &Arbiter{}
+		dict.On("Verdict", "word").
+			Return(dictionary.Verdict{}, dictionary.ErrorProviderUnavailable)
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{"id-id": dict})
+
+		_, err := svc.DisputeWord(ctx, gameId, playerId, "word")
+		assert.EqualError(t, err, service.ErrorDictionaryUnavailable.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		trans := testSuite()
+		trans.On("InsertDispute", ctx, mock.MatchedBy(func(dispute data.Dispute) bool {
+			return dispute.GameId == gameId && dispute.PlayerId == playerId && dispute.Language == "id-id" &&
+				dispute.Word == "word" && !dispute.Valid && dispute.Source == "online" && dispute.State == data.PENDING
+		})).
+			Return(nil)
+		dict := &Arbiter{}
+		dict.On("Verdict", "word").
+			Return(dictionary.Verdict{Valid: false, Source: "online"}, nil)
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{"id-id": dict})
+
+		dispute, err := svc.DisputeWord(ctx, gameId, playerId, " WORD ")
+		if assert.NoError(t, err) {
+			assert.Equal(t, disputeId, dispute.Id)
+		}
+		trans.AssertExpectations(t)
+	})
+	t.Run("SuccessOnBoard", func(t *testing.T) {
+		letters, _ := data.Letters("id")
+		letterIds := make(map[string]uint8)
+		for letterId, letter := range letters {
+			letterIds[letter] = uint8(letterId)
+		}
+		trans := &Transactional{}
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{BoardBase: []uint8{0, letterIds["r"], letterIds["o"], letterIds["w"], letterIds["d"], letterIds["o"]}}, nil)
+		trans.On("GetPlayersByGameId", ctx, gameId).
+			Return(players, nil)
+		trans.On("GetPlayedWordsByGameId", ctx, gameId).
+			Return([]data.PlayedWord{}, nil)
+		trans.On("GetPendingDispute", ctx, "id-id", "word").
+			Return(data.Dispute{}, false, nil)
+		trans.On("InsertDispute", ctx, mock.Anything).
+			Return(nil)
+		dict := &Arbiter{}
+		dict.On("Verdict", "word").
+			Return(dictionary.Verdict{Valid: false, Source: "online"}, nil)
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{"id-id": dict})
+
+		dispute, err := svc.DisputeWord(ctx, gameId, playerId, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, disputeId, dispute.Id)
+		}
+		trans.AssertExpectations(t)
+	})
+	t.Run("SuccessPendingDispute", func(t *testing.T) {
+		pending := data.Dispute{Id: disputeId, Language: "id-id", Word: "word", State: data.PENDING}
+		trans := &Transactional{}
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{}, nil)
+		trans.On("GetPlayersByGameId", ctx, gameId).
+			Return(players, nil)
+		trans.On("GetPlayedWordsByGameId", ctx, gameId).
+			Return([]data.PlayedWord{{PlayerId: players[1].Id, Word: "word"}}, nil)
+		trans.On("GetPendingDispute", ctx, "id-id", "word").
+			Return(pending, true, nil)
+		dict := &Arbiter{}
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{"id-id": dict})
+
+		dispute, err := svc.DisputeWord(ctx, gameId, playerId, "word")
+		if assert.NoError(t, err) {
+			assert.Equal(t, pending, dispute)
+		}
+		trans.AssertNotCalled(t, "InsertDispute", mock.Anything, mock.Anything)
+		dict.AssertNotCalled(t, "Verdict", mock.Anything)
+	})
+}
+
+func TestApplication_DecideDispute(t *testing.T) {
+	pending := data.Dispute{Id: disputeId, Language: "id-id", Word: "word", Valid: false, State: data.PENDING}
+	testSuite := func(dispute data.Dispute, getError, expectedError error) *Transactional {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetDisputeById", ctx, tx, disputeId).
+			Return(dispute, getError)
+		trans.On("FinalizeTransaction", tx, expectedError).
+			Return(nil)
+		return trans
+	}
+
+	t.Run("ErrorDisputeNotFound", func(t *testing.T) {
+		trans := testSuite(data.Dispute{}, sql.ErrNoRows, service.ErrorDisputeNotFound)
+		svc := service.NewService(trans, nil)
+
+		_, err := svc.DecideDispute(ctx, disputeId, true, "moderator")
+		assert.EqualError(t, err, service.ErrorDisputeNotFound.Error())
+	})
+	t.Run("ErrorDisputeDecided", func(t *testing.T) {
+		decided := pending
+		decided.State = data.REJECTED
+		trans := testSuite(decided, nil, service.ErrorDisputeDecided)
+		svc := service.NewService(trans, nil)
+
+		_, err := svc.DecideDispute(ctx, disputeId, true, "moderator")
+		assert.EqualError(t, err, service.ErrorDisputeDecided.Error())
+	})
+	t.Run("ErrorUpsertWordOverride", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+		trans := testSuite(pending, nil, unexpectedError)
+		trans.On("UpdateDispute", ctx, tx, mock.Anything).
+			Return(nil)
+		trans.On("UpsertWordOverride", ctx, tx, mock.Anything).
+			Return(unexpectedError)
+		svc := service.NewService(trans, nil)
+
+		_, err := svc.DecideDispute(ctx, disputeId, true, "moderator")
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Approve", func(t *testing.T) {
+		trans := testSuite(pending, nil, nil)
+		trans.On("UpdateDispute", ctx, tx, mock.MatchedBy(func(dispute data.Dispute) bool {
+			return dispute.State == data.APPROVED && dispute.Moderator == "moderator" && dispute.DecidedAt > 0
+		})).
+			Return(nil)
+		trans.On("UpsertWordOverride", ctx, tx, data.WordOverride{
+			Language: "id-id", Word: "word", Valid: true, DisputeId: disputeId,
+		}).
+			Return(nil)
+		svc := service.NewService(trans, nil)
+
+		dispute, err := svc.DecideDispute(ctx, disputeId, true, "moderator")
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.APPROVED, dispute.State)
+		}
+		trans.AssertExpectations(t)
+	})
+	t.Run("Reject", func(t *testing.T) {
+		trans := testSuite(pending, nil, nil)
+		trans.On("UpdateDispute", ctx, tx, mock.MatchedBy(func(dispute data.Dispute) bool {
+			return dispute.State == data.REJECTED
+		})).
+			Return(nil)
+		svc := service.NewService(trans, nil)
+
+		dispute, err := svc.DecideDispute(ctx, disputeId, false, "moderator")
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.REJECTED, dispute.State)
+		}
+		trans.AssertNotCalled(t, "UpsertWordOverride", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -35,8 +35,8 @@ func TestApplication_GetGame(t *testing.T) {
 			Return(game, nil)
 
 		playedWords := []data.PlayedWord{
-			{players[0].Id, "KATA"},
-			{players[1].Id, "KITA"},
+			{PlayerId: players[0].Id, Word: "KATA"},
+			{PlayerId: players[1].Id, Word: "KITA"},
 		}
 		trans.On("GetPlayedWordsByGameId", ctx, gameId).
 			Return(playedWords, nil)
@@ -6,12 +6,34 @@ import (
 	"github.com/satriahrh/letter-block/data"
 )
 
-func (a *application) NewGame(ctx context.Context, firstPlayerId data.PlayerId, numberOfPlayer uint8) (game data.Game, err error) {
+func (a *application) NewGame(ctx context.Context, firstPlayerId data.PlayerId, numberOfPlayer uint8, settings data.GameSettings) (game data.Game, err error) {
 	if numberOfPlayer < 2 || 5 < numberOfPlayer {
 		err = ErrorNumberOfPlayer
 		return
 	}
 
+	if settings.Mode != data.AREA && settings.Mode != data.SCORE {
+		err = ErrorGameSettings
+		return
+	}
+
+	settings.Language = settings.TileLanguage()
+	dictionaryLanguage, languageErr := data.DictionaryLanguage(settings.Language)
+	if languageErr != nil {
+		err = ErrorGameSettings
+		return
+	}
+	// the words of the game could not be checked
+	if _, ok := a.dictionaries[dictionaryLanguage]; !ok {
+		err = ErrorGameSettings
+		return
+	}
+
+	settings.HouseRules, err = a.houseRules(ctx, firstPlayerId, settings.HouseRules)
+	if err != nil {
+		return
+	}
+
 	player, err := a.transactional.GetPlayerById(ctx, firstPlayerId)
 	if err != nil {
 		return
@@ -28,20 +50,34 @@ func (a *application) NewGame(ctx context.Context, firstPlayerId data.PlayerId,
 		}
 	}()
 
-	// can ignore the error since we know that id is exist in
-	letterBank, _ := data.NewLetterBank("id")
+	letterBank, err := data.NewLetterBank(settings.Language)
+	if err != nil {
+		return
+	}
 	letterBank.Shuffle()
 
-	// can ignore the error since the initial bank would be 98
-	boardBase := letterBank.Pop(25)
+	boardBase := letterBank.Pop(boardWidth * boardWidth)
+	if len(boardBase) < boardWidth*boardWidth {
+		// the registered tiles cannot fill the board
+		err = data.ErrorTilesInvalid
+		return
+	}
+
+	boardModifiers := make([]uint8, boardWidth*boardWidth)
+	if settings.BonusTiles {
+		boardModifiers = data.NewBoardModifiers(boardWidth * boardWidth)
+	}
 
 	game = data.Game{
 		CurrentPlayerOrder: 0,
 		NumberOfPlayer:     numberOfPlayer,
 		LetterBank:         letterBank,
 		BoardBase:          boardBase,
-		BoardPositioning:   make([]uint8, 25),
+		BoardPositioning:   make([]uint8, boardWidth*boardWidth),
+		BoardModifiers:     boardModifiers,
 		State:              data.ONGOING,
+		Settings:           settings,
+		Scores:             make(data.Scores, numberOfPlayer),
 	}
 
 	game, err = a.transactional.InsertGame(ctx, tx, game)
@@ -3,6 +3,7 @@ package service_test
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/satriahrh/letter-block/data"
@@ -12,11 +13,16 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// dictionaries registers the dictionary of the default tiles language
+func dictionaries() map[string]dictionary.Dictionary {
+	return map[string]dictionary.Dictionary{"id-id": &Dictionary{}}
+}
+
 func TestApplicationNewGame(t *testing.T) {
 	t.Run("ErrorNumberOfPlayer", func(t *testing.T) {
 		testSuite := func(t *testing.T, sample uint8) {
-			svc := service.NewService(&Transactional{}, make(map[string]dictionary.Dictionary))
-			_, err := svc.NewGame(ctx, playerId, sample)
+			svc := service.NewService(&Transactional{}, dictionaries())
+			_, err := svc.NewGame(ctx, playerId, sample, data.GameSettings{})
 			assert.EqualError(t, err, service.ErrorNumberOfPlayer.Error())
 		}
 		t.Run("BelowTwo", func(t *testing.T) {
@@ -26,13 +32,54 @@ func TestApplicationNewGame(t *testing.T) {
 			testSuite(t, 6)
 		})
 	})
+	t.Run("ErrorGameSettings", func(t *testing.T) {
+		svc := service.NewService(&Transactional{}, dictionaries())
+		_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{Mode: data.GameMode(9)})
+		assert.EqualError(t, err, service.ErrorGameSettings.Error())
+
+		_, err = svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{Language: "--"})
+		assert.EqualError(t, err, service.ErrorGameSettings.Error(), "unknown language")
+
+		_, err = svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{Language: "en"})
+		assert.EqualError(t, err, service.ErrorGameSettings.Error(), "no dictionary of the language")
+	})
+	t.Run("ErrorHouseRules", func(t *testing.T) {
+		testSuite := func(wordList data.WordList, wordListErr error, expectedError error) {
+			trans := &Transactional{}
+			trans.On("GetWordListById", ctx, wordListId).
+				Return(wordList, wordListErr)
+
+			svc := service.NewService(trans, dictionaries())
+			_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{
+				HouseRules: data.HouseRules{BannedLists: []data.WordListId{wordListId}},
+			})
+			assert.EqualError(t, err, expectedError.Error())
+		}
+		t.Run("WordListNotFound", func(t *testing.T) {
+			testSuite(data.WordList{}, sql.ErrNoRows, service.ErrorWordListInvalid)
+		})
+		t.Run("WordListOfOthers", func(t *testing.T) {
+			testSuite(data.WordList{Id: wordListId, PlayerId: players[1].Id}, nil, service.ErrorUnauthorized)
+		})
+		t.Run("TooManyWords", func(t *testing.T) {
+			words := make([]string, 1001)
+			for i := range words {
+				words[i] = fmt.Sprintf("word%v", i)
+			}
+			svc := service.NewService(&Transactional{}, dictionaries())
+			_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{
+				HouseRules: data.HouseRules{AllowedWords: words},
+			})
+			assert.EqualError(t, err, service.ErrorGameSettings.Error())
+		})
+	})
 	t.Run("ErrorGetPlayerById", func(t *testing.T) {
 		trans := &Transactional{}
 		trans.On("GetPlayerById", playerId).
 			Return(data.Player{}, sql.ErrNoRows)
 
-		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.NewGame(ctx, playerId, numberOfPlayer)
+		svc := service.NewService(trans, dictionaries())
+		_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{})
 		assert.EqualError(t, err, sql.ErrNoRows.Error())
 	})
 	t.Run("ErrorBeginTransaction", func(t *testing.T) {
@@ -42,8 +89,8 @@ func TestApplicationNewGame(t *testing.T) {
 		trans.On("BeginTransaction", ctx).
 			Return(&sql.Tx{}, sql.ErrConnDone)
 
-		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.NewGame(ctx, playerId, numberOfPlayer)
+		svc := service.NewService(trans, dictionaries())
+		_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{})
 		assert.EqualError(t, err, sql.ErrConnDone.Error())
 	})
 	t.Run("ErrorInsertGame", func(t *testing.T) {
@@ -67,8 +114,8 @@ func TestApplicationNewGame(t *testing.T) {
 		trans.On("FinalizeTransaction", tx, unexpectedError).
 			Return(nil)
 
-		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.NewGame(ctx, playerId, numberOfPlayer)
+		svc := service.NewService(trans, dictionaries())
+		_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{})
 		assert.EqualError(t, err, unexpectedError.Error())
 	})
 	t.Run("ErrorInsertGamePlayer", func(t *testing.T) {
@@ -103,8 +150,8 @@ func TestApplicationNewGame(t *testing.T) {
 		trans.On("FinalizeTransaction", tx, unexpectedError).
 			Return(nil)
 
-		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.NewGame(ctx, playerId, numberOfPlayer)
+		svc := service.NewService(trans, dictionaries())
+		_, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{})
 		assert.EqualError(t, err, unexpectedError.Error())
 	})
 	t.Run("Success", func(t *testing.T) {
@@ -139,8 +186,8 @@ func TestApplicationNewGame(t *testing.T) {
 			trans.On("FinalizeTransaction", tx, nil).
 				Return(finalizeError)
 
-			svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-			return svc.NewGame(ctx, playerId, numberOfPlayer)
+			svc := service.NewService(trans, dictionaries())
+			return svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{})
 		}
 		// Can be happened anywhere
 		t.Run("ErrorFinalizeTransaction", func(t *testing.T) {
@@ -149,6 +196,56 @@ func TestApplicationNewGame(t *testing.T) {
 			assert.EqualError(t, err, unexpectedError.Error())
 			assert.Empty(t, game)
 		})
+		t.Run("BonusTiles", func(t *testing.T) {
+			trans := &Transactional{}
+			trans.On("GetPlayerById", playerId).
+				Return(players[0], nil)
+			tx := &sql.Tx{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("InsertGame", ctx, tx, mock.Anything).
+				Return(nil)
+			trans.On("InsertGamePlayer", ctx, tx, mock.Anything, players[0]).
+				Return(nil)
+			trans.On("FinalizeTransaction", tx, nil).
+				Return(nil)
+
+			svc := service.NewService(trans, dictionaries())
+			game, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{BonusTiles: true})
+			if assert.NoError(t, err) && assert.Len(t, game.BoardModifiers, 25) {
+				assert.NotEqual(t, make([]uint8, 25), game.BoardModifiers)
+			}
+		})
+		t.Run("HouseRules", func(t *testing.T) {
+			trans := &Transactional{}
+			trans.On("GetWordListById", ctx, wordListId).
+				Return(data.WordList{Id: wordListId, PlayerId: playerId, Words: data.Words{"Anjay", "gokil"}}, nil)
+			trans.On("GetPlayerById", playerId).
+				Return(players[0], nil)
+			tx := &sql.Tx{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("InsertGame", ctx, tx, mock.Anything).
+				Return(nil)
+			trans.On("InsertGamePlayer", ctx, tx, mock.Anything, players[0]).
+				Return(nil)
+			trans.On("FinalizeTransaction", tx, nil).
+				Return(nil)
+
+			svc := service.NewService(trans, dictionaries())
+			game, err := svc.NewGame(ctx, playerId, numberOfPlayer, data.GameSettings{
+				HouseRules: data.HouseRules{
+					AllowedWords: []string{" gokil ", "anjay", "", "GOKIL"},
+					AllowedLists: []data.WordListId{wordListId},
+				},
+			})
+			if assert.NoError(t, err) {
+				assert.Equal(t, data.HouseRules{
+					AllowedWords: []string{"gokil", "anjay"},
+					AllowedLists: []data.WordListId{wordListId},
+				}, game.Settings.HouseRules)
+			}
+		})
 		t.Run("SuccessFinalizeTransaction", func(t *testing.T) {
 			game, err := testSuite(t, nil)
 			if assert.NoError(t, err) && assert.NotEmpty(t, game) {
@@ -158,6 +255,8 @@ func TestApplicationNewGame(t *testing.T) {
 				assert.Equal(t, make([]uint8, 25), game.BoardPositioning)
 				assert.Equal(t, players[:1], game.Players)
 				assert.Equal(t, gameId, game.Id)
+				assert.Equal(t, make(data.Scores, numberOfPlayer), game.Scores)
+				assert.Equal(t, "id", game.Settings.Language)
 			}
 		})
 	})
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"log"
+	"reflect"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+// Rollback is a move accepted while the dictionary was unavailable and found invalid later.
+// The game is back to the state before it, the moves made after it are rolled back too.
+type Rollback struct {
+	Game data.Game
+	Move data.Move
+}
+
+// VerifyPendingMoves checks the words of the pending moves, oldest first, and rolls back the games
+// having an invalid one. The moves are left pending while the dictionary is still unavailable.
+func (a *application) VerifyPendingMoves(ctx context.Context) (rollbacks []Rollback, err error) {
+	gameIds, err := a.transactional.GetPendingGameIds(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, gameId := range gameIds {
+		rollback, rolledBack, verifyErr := a.verifyPendingMoves(ctx, gameId)
+		if verifyErr != nil {
+			if ctx.Err() != nil {
+				return rollbacks, ctx.Err()
+			}
+			log.Printf("verifying the pending moves of game %v failed: %v", gameId, verifyErr)
+			continue
+		}
+		if rolledBack {
+			rollbacks = append(rollbacks, rollback)
+		}
+	}
+	return
+}
+
+// verifyPendingMoves asks the dictionary outside of any transaction, so the game is not locked meanwhile,
+// then applies the verdicts unless the pending moves have changed since.
+func (a *application) verifyPendingMoves(ctx context.Context, gameId data.GameId) (rollback Rollback, rolledBack bool, err error) {
+	game, err := a.transactional.GetGameById(ctx, nil, gameId)
+	if err != nil {
+		return
+	}
+
+	dictionaryLanguage, _ := data.DictionaryLanguage(game.Settings.TileLanguage())
+	dict, ok := a.dictionaries[dictionaryLanguage]
+	if !ok {
+		err = ErrorDictionaryUnavailable
+		return
+	}
+
+	verified := make([]bool, len(game.PendingMoves))
+	invalid := -1
+	for i, move := range game.PendingMoves {
+		if !move.Pending {
+			continue
+		}
+		valid, dictionaryErr := dict.LemmaIsValid(ctx, move.Word)
+		if dictionaryErr != nil {
+			// still unavailable, verified on the next run
+			break
+		}
+		if !valid {
+			invalid = i
+			break
+		}
+		verified[i] = true
+	}
+
+	tx, err := a.transactional.BeginTransaction(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = a.transactional.FinalizeTransaction(tx, err)
+	}()
+
+	locked, err := a.transactional.GetGameById(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+	if !reflect.DeepEqual(locked.PendingMoves, game.PendingMoves) {
+		// a move was taken or undone meanwhile, verified on the next run
+		return
+	}
+	game = locked
+
+	for i := range verified {
+		if verified[i] {
+			game.PendingMoves[i].Pending = false
+		}
+	}
+	if invalid >= 0 {
+		move := game.PendingMoves[invalid]
+		for _, rolledBackMove := range game.PendingMoves[invalid:] {
+			err = a.transactional.DeletePlayedWord(ctx, tx, gameId, rolledBackMove.Word)
+			if err != nil {
+				return
+			}
+		}
+		game.Restore(move)
+		game.PendingMoves = game.PendingMoves[:invalid]
+		rollback.Move = data.Move{PlayerId: move.PlayerId, Word: move.Word, RolledBack: true}
+		rolledBack = true
+	}
+
+	// the moves before the oldest pending one are settled
+	for len(game.PendingMoves) > 0 && !game.PendingMoves[0].Pending {
+		game.PendingMoves = game.PendingMoves[1:]
+	}
+
+	err = a.transactional.UpdateGame(ctx, tx, game)
+	if err != nil {
+		return
+	}
+
+	if rolledBack {
+		var gamePlayers []data.GamePlayer
+		gamePlayers, err = a.transactional.GetGamePlayersByGameId(ctx, tx, gameId)
+		if err != nil {
+			return
+		}
+		for _, gamePlayer := range gamePlayers {
+			game.Players = append(game.Players, data.Player{Id: gamePlayer.PlayerId})
+		}
+		rollback.Game = game
+	}
+	return
+}
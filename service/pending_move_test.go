@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplication_VerifyPendingMoves(t *testing.T) {
+	pendingMove := data.GameSnapshot{
+		PlayerId: players[0].Id, Word: "mkn", Pending: true,
+		CurrentPlayerOrder: 0, State: data.ONGOING,
+		BoardPositioning: make([]uint8, 25), BoardBase: boardBaseFresh(), LetterBank: letterBank,
+	}
+	followingMove := data.GameSnapshot{
+		PlayerId: players[1].Id, Word: "minum",
+		CurrentPlayerOrder: 1, State: data.ONGOING,
+		BoardPositioning: []uint8{1, 1, 1}, BoardBase: boardBaseFresh(), LetterBank: letterBank,
+	}
+	testSuite := func(valid bool, dictionaryError error) (*Transactional, []service.Rollback, error) {
+		trans := &Transactional{}
+		trans.On("GetPendingGameIds", ctx).
+			Return([]data.GameId{gameId}, nil)
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		game := func() data.Game {
+			return data.Game{
+				CurrentPlayerOrder: 0, NumberOfPlayer: 2, State: data.ONGOING,
+				BoardPositioning: []uint8{1, 1, 1, 2, 2},
+				PendingMoves:     data.PendingMoves{pendingMove, followingMove},
+			}
+		}
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(game(), nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(game(), nil)
+		trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+			Return(gamePlayers[:2], nil)
+		trans.On("DeletePlayedWord", ctx, tx, gameId, "mkn").
+			Return(nil)
+		trans.On("DeletePlayedWord", ctx, tx, gameId, "minum").
+			Return(nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, nil).
+			Return(nil)
+
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "mkn").
+			Return(valid, dictionaryError)
+
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		rollbacks, err := svc.VerifyPendingMoves(ctx)
+		return trans, rollbacks, err
+	}
+
+	t.Run("ErrorGetPendingGameIds", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("GetPendingGameIds", ctx).
+			Return([]data.GameId{}, sql.ErrConnDone)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		_, err := svc.VerifyPendingMoves(ctx)
+		assert.EqualError(t, err, sql.ErrConnDone.Error())
+	})
+	t.Run("ErrorGetGameByIdSkipsTheGame", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("GetPendingGameIds", ctx).
+			Return([]data.GameId{gameId}, nil)
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{}, sql.ErrConnDone)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		rollbacks, err := svc.VerifyPendingMoves(ctx)
+		if assert.NoError(t, err) {
+			assert.Empty(t, rollbacks)
+			trans.AssertNotCalled(t, "BeginTransaction", ctx)
+		}
+	})
+	t.Run("ChangedMeanwhile", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("GetPendingGameIds", ctx).
+			Return([]data.GameId{gameId}, nil)
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{NumberOfPlayer: 2, PendingMoves: data.PendingMoves{pendingMove}}, nil)
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{NumberOfPlayer: 2, PendingMoves: data.PendingMoves{pendingMove, followingMove}}, nil)
+		trans.On("FinalizeTransaction", tx, nil).
+			Return(nil)
+
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "mkn").
+			Return(false, nil)
+
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		rollbacks, err := svc.VerifyPendingMoves(ctx)
+		if assert.NoError(t, err) {
+			assert.Empty(t, rollbacks, "verified on the next run")
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "mkn")
+			trans.AssertNotCalled(t, "UpdateGame")
+		}
+	})
+	t.Run("VerifiedOutsideOfTheTransaction", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("GetPendingGameIds", ctx).
+			Return([]data.GameId{gameId}, nil)
+		trans.On("GetGameById", ctx, (*sql.Tx)(nil), gameId).
+			Return(data.Game{NumberOfPlayer: 2, PendingMoves: data.PendingMoves{pendingMove}}, nil)
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{NumberOfPlayer: 2, PendingMoves: data.PendingMoves{pendingMove}}, nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, nil).
+			Return(nil)
+
+		dict := &Dictionary{}
+		dict.On("LemmaIsValid", "mkn").
+			Return(true, nil).
+			Run(func(mock.Arguments) {
+				trans.AssertNotCalled(t, "BeginTransaction", ctx)
+			})
+
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		_, err := svc.VerifyPendingMoves(ctx)
+		assert.NoError(t, err)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		trans, rollbacks, err := testSuite(true, nil)
+		if assert.NoError(t, err) {
+			assert.Empty(t, rollbacks)
+			trans.AssertCalled(t, "UpdateGame")
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "mkn")
+		}
+	})
+	t.Run("StillUnavailable", func(t *testing.T) {
+		trans, rollbacks, err := testSuite(false, dictionary.ErrorProviderUnavailable)
+		if assert.NoError(t, err) {
+			assert.Empty(t, rollbacks)
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "mkn")
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		trans, rollbacks, err := testSuite(false, nil)
+		if assert.NoError(t, err) && assert.Len(t, rollbacks, 1) {
+			rollback := rollbacks[0]
+			assert.Equal(t, data.Move{PlayerId: players[0].Id, Word: "mkn", RolledBack: true}, rollback.Move)
+			assert.Equal(t, make([]uint8, 25), rollback.Game.BoardPositioning, "back to the state before the move")
+			assert.Empty(t, rollback.Game.PendingMoves)
+			assert.Len(t, rollback.Game.Players, 2)
+			trans.AssertCalled(t, "DeletePlayedWord", ctx, tx, gameId, "mkn")
+			trans.AssertCalled(t, "DeletePlayedWord", ctx, tx, gameId, "minum")
+		}
+	})
+}
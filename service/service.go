@@ -9,28 +9,52 @@ import (
 )
 
 var (
-	ErrorDoesntMakeWord   = errors.New("doesn't make word")
-	ErrorGameIsUnplayable = errors.New("game is unplayable")
-	ErrorPlayerIsEnough   = errors.New("player is enough")
-	ErrorNotYourTurn      = errors.New("not your turn")
-	ErrorNumberOfPlayer   = errors.New("number of player invalid")
-	ErrorUnauthorized     = errors.New("player is not authorized")
-	ErrorWordHavePlayed   = errors.New("word have played")
-	ErrorWordInvalid      = errors.New("word invalid")
+	ErrorChallengeNotAllowed   = errors.New("challenge not allowed")
+	ErrorDictionaryBusy        = errors.New("dictionary is busy, try again in a moment")
+	ErrorDictionaryUnavailable = errors.New("dictionary is unavailable, try again later")
+	ErrorDisputeDecided        = errors.New("dispute is decided already")
+	ErrorDisputeNotFound       = errors.New("dispute not found")
+	ErrorDoesntMakeWord        = errors.New("doesn't make word")
+	ErrorGameIsUnplayable      = errors.New("game is unplayable")
+	ErrorGameSettings          = errors.New("game settings invalid")
+	ErrorNoDefinition          = errors.New("no definition found")
+	ErrorPlayerIsEnough        = errors.New("player is enough")
+	ErrorNotYourTurn           = errors.New("not your turn")
+	ErrorNoUndoRequested       = errors.New("no undo requested")
+	ErrorNumberOfPlayer        = errors.New("number of player invalid")
+	ErrorUnauthorized          = errors.New("player is not authorized")
+	ErrorUndoNotAllowed        = errors.New("undo not allowed")
+	ErrorWordHavePlayed        = errors.New("word have played")
+	ErrorWordBanned            = errors.New("word is banned in this game")
+	ErrorWordInvalid           = errors.New("word invalid")
+	ErrorWordListInvalid       = errors.New("word list invalid")
+	ErrorWordNotDisputable     = errors.New("word is neither played nor on the board of the game")
 )
 
 const (
-	alphabet    = "abcdefghijklmnopqrstuvwxyz"
-	maxStrength = 2
+	alphabet     = "abcdefghijklmnopqrstuvwxyz"
+	boardWidth   = 5
+	maxStrength  = data.MaxStrength
+	captureBonus = 2
 )
 
 type Service interface {
-	NewGame(ctx context.Context, firstPlayerId data.PlayerId, numberOfPlayer uint8) (data.Game, error)
-	TakeTurn(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word []uint8) (data.Game, error)
+	NewGame(ctx context.Context, firstPlayerId data.PlayerId, numberOfPlayer uint8, settings data.GameSettings) (data.Game, error)
+	TakeTurn(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word []uint8) (data.Game, data.Move, error)
 	JoinGame(ctx context.Context, gameId data.GameId, playerId data.PlayerId) (data.Game, error)
+	RequestUndo(ctx context.Context, gameId data.GameId, playerId data.PlayerId) (data.Game, error)
+	RespondUndo(ctx context.Context, gameId data.GameId, playerId data.PlayerId, accept bool) (data.Game, error)
+	ChallengeWord(ctx context.Context, gameId data.GameId, playerId data.PlayerId) (data.Game, data.Move, error)
 	GetGames(ctx context.Context, playerId data.PlayerId) ([]data.Game, error)
 	GetGame(ctx context.Context, gameId data.GameId) (game data.Game, err error)
 	GetPlayer(ctx context.Context, playerId data.PlayerId) (player data.Player, err error)
+	DefineWord(ctx context.Context, language, word string) (entry data.Entry, err error)
+	DisputeWord(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word string) (data.Dispute, error)
+	GetDisputes(ctx context.Context, state data.DisputeState) ([]data.Dispute, error)
+	DecideDispute(ctx context.Context, disputeId data.DisputeId, approve bool, moderator string) (data.Dispute, error)
+	SaveWordList(ctx context.Context, playerId data.PlayerId, name string, words []string) (data.WordList, error)
+	GetWordLists(ctx context.Context, playerId data.PlayerId) ([]data.WordList, error)
+	VerifyPendingMoves(ctx context.Context) ([]Rollback, error)
 }
 
 type application struct {
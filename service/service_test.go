@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -29,6 +30,10 @@ var (
 
 	gamePlayerId = gamePlayers[0].Id
 
+	disputeId = data.DisputeId(time.Now().UnixNano())
+
+	wordListId = data.WordListId(time.Now().UnixNano())
+
 	word       = []uint8{0, 1, 2, 3}
 	boardBase  = []uint8{23, 15, 18, 4, 1, 2, 3, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 18, 19, 20, 21, 22, 23}
 	letterBank = data.LetterBank([]uint8{
@@ -99,11 +104,29 @@ type Dictionary struct {
 	mock.Mock
 }
 
-func (d *Dictionary) LemmaIsValid(lemma string) (result bool, err error) {
+func (d *Dictionary) LemmaIsValid(ctx context.Context, lemma string) (result bool, err error) {
 	args := d.Called(lemma)
 	return args.Bool(0), args.Error(1)
 }
 
+type Definer struct {
+	Dictionary
+}
+
+func (d *Definer) Define(ctx context.Context, lemma string) (data.Entry, error) {
+	args := d.Called(lemma)
+	return args.Get(0).(data.Entry), args.Error(1)
+}
+
+type Arbiter struct {
+	Dictionary
+}
+
+func (d *Arbiter) Verdict(ctx context.Context, lemma string) (dictionary.Verdict, error) {
+	args := d.Called(lemma)
+	return args.Get(0).(dictionary.Verdict), args.Error(1)
+}
+
 type Transactional struct {
 	mock.Mock
 }
@@ -191,6 +214,10 @@ func (t *Transactional) GetPlayedWordsByGameId(ctx context.Context, gameId data.
 	return
 }
 
+func (t *Transactional) DeletePlayedWord(ctx context.Context, tx *sql.Tx, gameId data.GameId, word string) error {
+	return t.Called(ctx, tx, gameId, word).Error(0)
+}
+
 func (t *Transactional) UpdateGame(ctx context.Context, tx *sql.Tx, game data.Game) error {
 	return t.Called().Error(0)
 }
@@ -207,6 +234,90 @@ func (t *Transactional) GetPlayerByDeviceFingerprint(ctx context.Context, tx *sq
 	return
 }
 
+func (t *Transactional) InsertDispute(ctx context.Context, dispute data.Dispute) (data.Dispute, error) {
+	err := t.Called(ctx, dispute).Error(0)
+	if err != nil {
+		return data.Dispute{}, err
+	}
+	dispute.Id = disputeId
+	return dispute, nil
+}
+
+func (t *Transactional) GetDisputeById(ctx context.Context, tx *sql.Tx, disputeId data.DisputeId) (data.Dispute, error) {
+	args := t.Called(ctx, tx, disputeId)
+	return args.Get(0).(data.Dispute), args.Error(1)
+}
+
+func (t *Transactional) GetDisputesByState(ctx context.Context, state data.DisputeState) ([]data.Dispute, error) {
+	args := t.Called(ctx, state)
+	return args.Get(0).([]data.Dispute), args.Error(1)
+}
+
+func (t *Transactional) UpdateDispute(ctx context.Context, tx *sql.Tx, dispute data.Dispute) error {
+	return t.Called(ctx, tx, dispute).Error(0)
+}
+
+func (t *Transactional) UpsertWordOverride(ctx context.Context, tx *sql.Tx, override data.WordOverride) error {
+	return t.Called(ctx, tx, override).Error(0)
+}
+
+func (t *Transactional) GetPendingDispute(ctx context.Context, language, word string) (data.Dispute, bool, error) {
+	args := t.Called(ctx, language, word)
+	return args.Get(0).(data.Dispute), args.Bool(1), args.Error(2)
+}
+
+func (t *Transactional) GetWordOverride(ctx context.Context, language, word string) (data.WordOverride, bool, error) {
+	args := t.Called(ctx, language, word)
+	return args.Get(0).(data.WordOverride), args.Bool(1), args.Error(2)
+}
+
+func (t *Transactional) InsertWordList(ctx context.Context, wordList data.WordList) (data.WordList, error) {
+	err := t.Called(ctx, wordList).Error(0)
+	if err != nil {
+		return data.WordList{}, err
+	}
+	wordList.Id = wordListId
+	return wordList, nil
+}
+
+func (t *Transactional) GetWordListById(ctx context.Context, wordListId data.WordListId) (data.WordList, error) {
+	args := t.Called(ctx, wordListId)
+	return args.Get(0).(data.WordList), args.Error(1)
+}
+
+func (t *Transactional) GetWordListsByPlayerId(ctx context.Context, playerId data.PlayerId) ([]data.WordList, error) {
+	args := t.Called(ctx, playerId)
+	return args.Get(0).([]data.WordList), args.Error(1)
+}
+
+func (t *Transactional) GetLemma(ctx context.Context, language, word string) (data.Lemma, bool, error) {
+	args := t.Called(ctx, language, word)
+	return args.Get(0).(data.Lemma), args.Bool(1), args.Error(2)
+}
+
+func (t *Transactional) UpsertLemma(ctx context.Context, lemma data.Lemma) error {
+	return t.Called(ctx, lemma).Error(0)
+}
+
+func (t *Transactional) DeleteLemma(ctx context.Context, language, word string) error {
+	return t.Called(ctx, language, word).Error(0)
+}
+
+func (t *Transactional) GetPendingGameIds(ctx context.Context) ([]data.GameId, error) {
+	args := t.Called(ctx)
+	return args.Get(0).([]data.GameId), args.Error(1)
+}
+
+func (t *Transactional) GetValidLemmaWords(ctx context.Context, language string) ([]string, error) {
+	args := t.Called(ctx, language)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (t *Transactional) GetValidOverrideWords(ctx context.Context, language string) ([]string, error) {
+	args := t.Called(ctx, language)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func buildGame(trait string, build data.Game) data.Game {
 	game := data.Game{
 		Id:                 0,
@@ -3,11 +3,13 @@ package service
 import (
 	"context"
 	"regexp"
+	"strings"
 
 	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
 )
 
-func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word []uint8) (game data.Game, err error) {
+func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId data.PlayerId, word []uint8) (game data.Game, move data.Move, err error) {
 	tx, err := a.transactional.BeginTransaction(ctx)
 	if err != nil {
 		return
@@ -39,12 +41,27 @@ func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId
 		return
 	}
 
+	game.Players = []data.Player{}
+	for _, gamePlayer := range gamePlayers {
+		game.Players = append(game.Players, data.Player{Id: gamePlayer.PlayerId})
+	}
+
+	previousState := game.Snapshot()
+	releaseLocks(game)
+
 	game.LetterBank.Shuffle()
 	newWord := game.LetterBank.Pop(uint(len(word)))
-	letters, _ := data.Letters("id")
+	language := game.Settings.TileLanguage()
+	letters, _ := data.Letters(language)
+	letterPoints, _ := data.LetterPoints(language)
+	if len(letters) == 0 {
+		err = ErrorGameIsUnplayable
+		return
+	}
 
 	wordOnce := make(map[uint8]bool)
-	wordByte := make([]byte, len(word))
+	var wordBuilder strings.Builder
+	wordPoints, wordMultiplier := uint32(0), uint32(1)
 	for i, wordPosition := range word {
 		if wordOnce[wordPosition] {
 			err = ErrorDoesntMakeWord
@@ -53,19 +70,56 @@ func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId
 			wordOnce[wordPosition] = true
 		}
 		letterId := game.BoardBase[wordPosition]
-		wordByte[i] = letters[letterId]
-		game.BoardBase[wordPosition] = newWord[i]
+		if letterId == 0 || int(letterId) >= len(letters) {
+			// a blank left by an empty bank
+			err = ErrorDoesntMakeWord
+			return
+		}
+		wordBuilder.WriteString(letters[letterId])
+		switch tileModifier(game, wordPosition) {
+		case data.DOUBLE_LETTER:
+			wordPoints += 2 * uint32(letterPoints[letterId])
+		case data.DOUBLE_WORD:
+			wordPoints += uint32(letterPoints[letterId])
+			wordMultiplier *= 2
+		default:
+			wordPoints += uint32(letterPoints[letterId])
+		}
+		game.BoardBase[wordPosition] = 0
+		if i < len(newWord) {
+			game.BoardBase[wordPosition] = newWord[i]
+		}
 	}
 
-	wordString := string(wordByte)
-	var valid bool
-	valid, err = a.dictionaries["id-id"].LemmaIsValid(wordString)
-	if err != nil {
+	wordString := data.FoldWord(wordBuilder.String())
+	// the house rules of the game are checked before the dictionary
+	valid, decided := game.Settings.HouseRules.Verdict(wordString)
+	if decided && !valid {
+		err = ErrorWordBanned
 		return
 	}
-	if !valid {
-		err = ErrorWordInvalid
-		return
+	pending := false
+	// in challenge mode the word stands until the next player challenges it
+	if !decided && !game.Settings.Challenge {
+		dictionaryLanguage, _ := data.DictionaryLanguage(language)
+		dict, ok := a.dictionaries[dictionaryLanguage]
+		if !ok {
+			err = ErrorDictionaryUnavailable
+			return
+		}
+		valid, err = dict.LemmaIsValid(ctx, wordString)
+		if err != nil && game.Settings.ProvisionalTurns && ctx.Err() == nil {
+			// accepted for now, VerifyPendingMoves checks the word once the dictionary is back
+			valid, pending, err = true, true, nil
+		}
+		if err != nil {
+			err = dictionaryError(ctx, err)
+			return
+		}
+		if !valid {
+			err = ErrorWordInvalid
+			return
+		}
 	}
 
 	err = a.transactional.LogPlayedWord(ctx, tx, game.Id, playerId, wordString)
@@ -76,28 +130,49 @@ func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId
 		return
 	}
 
-	positioningSpace := uint8(len(gamePlayers)) + 1
+	move = data.Move{
+		PlayerId:  playerId,
+		Word:      wordString,
+		Positions: word,
+		Captured:  make(map[uint8][]uint8),
+		Drawn:     newWord,
+		Pending:   pending,
+	}
+
+	positioningSpace := game.PositioningSpace()
+	captured := uint32(0)
 	for _, position := range word {
 		boardPosition := game.BoardPositioning[position]
 		if boardPosition == 0 {
 			game.BoardPositioning[position] = game.CurrentPlayerOrder + 1
+			move.Claimed = append(move.Claimed, position)
 		} else {
 			ownedBy := boardPosition % positioningSpace
 			currentStrength := boardPosition/positioningSpace + 1
 			if ownedBy == game.CurrentPlayerOrder+1 {
 				if currentStrength < maxStrength {
 					game.BoardPositioning[position] += positioningSpace
+					move.Strengthened = append(move.Strengthened, position)
 				}
-			} else {
+			} else if tileModifier(game, position) != data.LOCKED {
 				if currentStrength > 1 {
 					game.BoardPositioning[position] -= positioningSpace
+					move.Weakened = append(move.Weakened, position)
 				} else {
 					game.BoardPositioning[position] = game.CurrentPlayerOrder + 1
+					move.Captured[ownedBy-1] = append(move.Captured[ownedBy-1], position)
+					captured++
 				}
 			}
 		}
 	}
 
+	move.Reset = resolveModifiers(game, word)
+
+	if game.Settings.Mode == data.SCORE {
+		game.Scores.Add(game.CurrentPlayerOrder, wordPoints*wordMultiplier+captured*captureBonus)
+	}
+
 	game.CurrentPlayerOrder += 1
 	if game.CurrentPlayerOrder >= game.NumberOfPlayer {
 		game.CurrentPlayerOrder = 0
@@ -107,6 +182,19 @@ func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId
 		game.State = data.END
 	}
 
+	previousState.PlayerId = playerId
+	previousState.Word = wordString
+	game.PreviousState = previousState
+	// an ended game is not undone, only its last word could still be challenged
+	if game.State == data.END && !game.Settings.Challenge {
+		game.PreviousState = data.GameSnapshot{}
+	}
+	// the moves following a pending one are kept too, they are rolled back along with it
+	if pending || len(game.PendingMoves) > 0 {
+		previousState.Pending = pending
+		game.PendingMoves = append(game.PendingMoves, previousState)
+	}
+
 	err = a.transactional.UpdateGame(ctx, tx, game)
 	if err != nil {
 		return
@@ -115,6 +203,17 @@ func (a *application) TakeTurn(ctx context.Context, gameId data.GameId, playerId
 	return
 }
 
+// dictionaryError translates the dictionary errors to the ones told to the player.
+func dictionaryError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == dictionary.ErrorRateLimited {
+		return ErrorDictionaryBusy
+	}
+	return ErrorDictionaryUnavailable
+}
+
 func gameIsEnding(game data.Game) bool {
 	for _, positioning := range game.BoardPositioning {
 		if positioning == 0 {
@@ -123,3 +222,51 @@ func gameIsEnding(game data.Game) bool {
 	}
 	return true
 }
+
+func tileModifier(game data.Game, position uint8) data.TileModifier {
+	if int(position) >= len(game.BoardModifiers) {
+		return data.PLAIN
+	}
+	return data.TileModifier(game.BoardModifiers[position])
+}
+
+// releaseLocks frees the tiles locked by the current player on their previous turn.
+func releaseLocks(game data.Game) {
+	positioningSpace := game.PositioningSpace()
+	for position, modifier := range game.BoardModifiers {
+		if data.TileModifier(modifier) == data.LOCKED &&
+			game.BoardPositioning[position]%positioningSpace == game.CurrentPlayerOrder+1 {
+			game.BoardModifiers[position] = uint8(data.PLAIN)
+		}
+	}
+}
+
+// resolveModifiers applies and consumes the modifiers under the played word,
+// returning the positions reset by bombs. A lock is only taken on a tile the current player owns after the move,
+// it is left for later on a tile they only weakened.
+func resolveModifiers(game data.Game, word []uint8) (reset []uint8) {
+	inWord := make(map[uint8]bool)
+	for _, position := range word {
+		inWord[position] = true
+	}
+
+	for _, position := range word {
+		switch tileModifier(game, position) {
+		case data.DOUBLE_LETTER, data.DOUBLE_WORD:
+			game.BoardModifiers[position] = uint8(data.PLAIN)
+		case data.LOCK:
+			if game.TileOwner(position) == game.CurrentPlayerOrder+1 {
+				game.BoardModifiers[position] = uint8(data.LOCKED)
+			}
+		case data.BOMB:
+			game.BoardModifiers[position] = uint8(data.PLAIN)
+			for _, neighbour := range data.Neighbours(position, boardWidth) {
+				if !inWord[neighbour] && tileModifier(game, neighbour) != data.LOCKED && game.BoardPositioning[neighbour] != 0 {
+					game.BoardPositioning[neighbour] = 0
+					reset = append(reset, neighbour)
+				}
+			}
+		}
+	}
+	return
+}
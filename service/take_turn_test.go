@@ -4,12 +4,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/satriahrh/letter-block/data"
 	"github.com/satriahrh/letter-block/dictionary"
 	"github.com/satriahrh/letter-block/service"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestApplicationTakeTurn(t *testing.T) {
@@ -19,7 +21,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			Return(&sql.Tx{}, sql.ErrConnDone)
 
 		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 		assert.EqualError(t, err, sql.ErrConnDone.Error())
 	})
 	t.Run("ErrorGetGameById", func(t *testing.T) {
@@ -32,7 +34,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			Return(nil)
 
 		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 		assert.EqualError(t, err, sql.ErrConnDone.Error())
 	})
 	t.Run("ErrorGameIsUnplayable", func(t *testing.T) {
@@ -48,7 +50,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 				Return(nil)
 
 			svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-			_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 			assert.EqualError(t, err, service.ErrorGameIsUnplayable.Error())
 		}
 		t.Run("Created", func(t *testing.T) {
@@ -73,7 +75,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			Return(nil)
 
 		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 		assert.EqualError(t, err, sql.ErrConnDone.Error())
 	})
 	t.Run("ErrorNotYourTurn", func(t *testing.T) {
@@ -92,7 +94,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 				Return(nil)
 
 			svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-			_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 			assert.EqualError(t, err, service.ErrorNotYourTurn.Error())
 		}
 		t.Run("WaitingForOtherPlayer", func(t *testing.T) {
@@ -126,39 +128,144 @@ func TestApplicationTakeTurn(t *testing.T) {
 			Return(nil)
 
 		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
-		_, err := svc.TakeTurn(ctx, gameId, playerId, append(word, word[0]))
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, append(word, word[0]))
 		assert.EqualError(t, err, service.ErrorDoesntMakeWord.Error())
 	})
 	t.Run("ErrorValidatingLemma", func(t *testing.T) {
+		testSuite := func(t *testing.T, dictionaryError, expectedError error) {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+					BoardBase: boardBaseFresh(), State: data.ONGOING,
+					LetterBank: letterBank,
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: playerId},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("FinalizeTransaction", tx, expectedError).
+				Return(nil)
+
+			dict := &Dictionary{}
+
+			dict.On("LemmaIsValid", "word").
+				Return(false, dictionaryError)
+
+			svc := service.NewService(trans, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			assert.EqualError(t, err, expectedError.Error())
+			fmt.Println(boardBase)
+		}
+		t.Run("Unexpected", func(t *testing.T) {
+			testSuite(t, errors.New("unexpected error"), service.ErrorDictionaryUnavailable)
+		})
+		t.Run("ProviderUnavailable", func(t *testing.T) {
+			testSuite(t, dictionary.ErrorProviderUnavailable, service.ErrorDictionaryUnavailable)
+		})
+		t.Run("RateLimited", func(t *testing.T) {
+			testSuite(t, dictionary.ErrorRateLimited, service.ErrorDictionaryBusy)
+		})
+	})
+	t.Run("ProvisionalTurns", func(t *testing.T) {
+		testSuite := func(pendingMoves data.PendingMoves, valid bool, dictionaryError error) (data.Game, data.Move, error) {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+					BoardBase: boardBaseFresh(), BoardPositioning: make([]uint8, 25), State: data.ONGOING,
+					LetterBank: letterBank, Settings: data.GameSettings{ProvisionalTurns: true},
+					PendingMoves: pendingMoves,
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: playerId},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("LogPlayedWord", ctx, tx, gameId, playerId).
+				Return(nil)
+			trans.On("UpdateGame").
+				Return(nil)
+			trans.On("FinalizeTransaction", tx, nil).
+				Return(nil)
+
+			dict := &Dictionary{}
+			dict.On("LemmaIsValid", "word").
+				Return(valid, dictionaryError)
+
+			svc := service.NewService(trans, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			return svc.TakeTurn(ctx, gameId, playerId, word)
+		}
+		t.Run("DictionaryUnavailable", func(t *testing.T) {
+			game, move, err := testSuite(nil, false, dictionary.ErrorProviderUnavailable)
+			if assert.NoError(t, err) {
+				assert.True(t, move.Pending)
+				if assert.Len(t, game.PendingMoves, 1) {
+					assert.Equal(t, "word", game.PendingMoves[0].Word)
+					assert.True(t, game.PendingMoves[0].Pending)
+					assert.Equal(t, uint8(0), game.PendingMoves[0].CurrentPlayerOrder, "the state before the move")
+				}
+			}
+		})
+		t.Run("FollowingPending", func(t *testing.T) {
+			game, move, err := testSuite(data.PendingMoves{{PlayerId: players[1].Id, Word: "kata", Pending: true}}, true, nil)
+			if assert.NoError(t, err) {
+				assert.False(t, move.Pending)
+				if assert.Len(t, game.PendingMoves, 2, "kept to be rolled back along with the pending one") {
+					assert.Equal(t, "word", game.PendingMoves[1].Word)
+					assert.False(t, game.PendingMoves[1].Pending)
+				}
+			}
+		})
+		t.Run("NothingPending", func(t *testing.T) {
+			game, move, err := testSuite(nil, true, nil)
+			if assert.NoError(t, err) {
+				assert.False(t, move.Pending)
+				assert.Empty(t, game.PendingMoves)
+			}
+		})
+	})
+	t.Run("Challenge", func(t *testing.T) {
 		trans := &Transactional{}
 		trans.On("BeginTransaction", ctx).
 			Return(tx, nil)
 		trans.On("GetGameById", ctx, tx, gameId).
 			Return(data.Game{
 				CurrentPlayerOrder: 0, NumberOfPlayer: 2,
-				BoardBase: boardBaseFresh(), State: data.ONGOING,
-				LetterBank: letterBank,
+				BoardBase: boardBaseFresh(), BoardPositioning: make([]uint8, 25), State: data.ONGOING,
+				LetterBank: letterBank, Settings: data.GameSettings{Challenge: true},
 			}, nil)
 		trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
 			Return([]data.GamePlayer{
 				{GameId: gameId, PlayerId: playerId},
 				{GameId: gameId, PlayerId: players[1].Id},
 			}, nil)
-		unexpectedError := errors.New("unexpected error")
-		trans.On("FinalizeTransaction", tx, unexpectedError).
+		trans.On("LogPlayedWord", ctx, tx, gameId, playerId).
+			Return(nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, nil).
 			Return(nil)
 
 		dict := &Dictionary{}
-
-		dict.On("LemmaIsValid", "word").
-			Return(false, unexpectedError)
-
 		svc := service.NewService(trans, map[string]dictionary.Dictionary{
 			"id-id": dict,
 		})
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
-		assert.EqualError(t, err, unexpectedError.Error())
-		fmt.Println(boardBase)
+		game, move, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "word", move.Word)
+			assert.Equal(t, playerId, game.PreviousState.PlayerId, "challengeable by the next player")
+			dict.AssertNotCalled(t, "LemmaIsValid", "word")
+		}
 	})
 	t.Run("ErrorWordInvalid", func(t *testing.T) {
 		trans := &Transactional{}
@@ -186,9 +293,121 @@ func TestApplicationTakeTurn(t *testing.T) {
 		svc := service.NewService(trans, map[string]dictionary.Dictionary{
 			"id-id": dict,
 		})
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 		assert.EqualError(t, err, service.ErrorWordInvalid.Error())
 	})
+	t.Run("HouseRules", func(t *testing.T) {
+		testSuite := func(rules data.HouseRules, expectedError error) *Dictionary {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+					BoardBase: boardBaseFresh(), State: data.ONGOING,
+					LetterBank: letterBank, Settings: data.GameSettings{HouseRules: rules},
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: playerId},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("LogPlayedWord", ctx, tx, gameId, playerId).
+				Return(expectedError)
+			trans.On("FinalizeTransaction", tx, expectedError).
+				Return(nil)
+
+			dict := &Dictionary{}
+			svc := service.NewService(trans, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			assert.EqualError(t, err, expectedError.Error())
+			return dict
+		}
+		t.Run("Banned", func(t *testing.T) {
+			dict := testSuite(data.HouseRules{
+				AllowedWords: []string{"word"},
+				BannedWords:  []string{"word"},
+			}, service.ErrorWordBanned)
+			dict.AssertNotCalled(t, "LemmaIsValid", mock.Anything)
+		})
+		t.Run("Allowed", func(t *testing.T) {
+			// the move goes on to logging the word without asking the dictionary
+			dict := testSuite(data.HouseRules{
+				AllowedWords: []string{"word"},
+			}, errors.New("unexpected error"))
+			dict.AssertNotCalled(t, "LemmaIsValid", mock.Anything)
+		})
+	})
+	t.Run("GameLanguage", func(t *testing.T) {
+		testSuite := func(language string, dictionaries map[string]dictionary.Dictionary, expectedError error) error {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+					BoardBase: boardBaseFresh(), State: data.ONGOING,
+					LetterBank: letterBank, Settings: data.GameSettings{Language: language},
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: playerId},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("FinalizeTransaction", tx, expectedError).
+				Return(nil)
+
+			svc := service.NewService(trans, dictionaries)
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			return err
+		}
+		t.Run("UsesTheLanguageDictionary", func(t *testing.T) {
+			dict := &Dictionary{}
+			dict.On("LemmaIsValid", "word").
+				Return(false, nil)
+
+			err := testSuite("en", map[string]dictionary.Dictionary{
+				"id-id": &Dictionary{},
+				"en-us": dict,
+			}, service.ErrorWordInvalid)
+			assert.EqualError(t, err, service.ErrorWordInvalid.Error())
+			dict.AssertExpectations(t)
+		})
+		t.Run("MultiCharacterLetters", func(t *testing.T) {
+			letters := strings.Split(" abcdefghijklmnopqrstuvwxyz", "")
+			letters[0] = ""
+			letters[15] = "O\u0308" // decomposed, uppercase
+			letters[23] = "ng"
+			distribution := make([]int, 26)
+			distribution[0] = data.BoardTiles
+			err := data.RegisterTiles("xx", data.Tiles{
+				Distribution: distribution,
+				Letters:      letters,
+				Dictionary:   "xx-xx",
+			})
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			dict := &Dictionary{}
+			dict.On("LemmaIsValid", "ng\u00f6rd").
+				Return(false, nil)
+
+			err = testSuite("xx", map[string]dictionary.Dictionary{
+				"xx-xx": dict,
+			}, service.ErrorWordInvalid)
+			assert.EqualError(t, err, service.ErrorWordInvalid.Error())
+			dict.AssertExpectations(t)
+		})
+		t.Run("ErrorNoDictionary", func(t *testing.T) {
+			err := testSuite("en", map[string]dictionary.Dictionary{
+				"id-id": &Dictionary{},
+			}, service.ErrorDictionaryUnavailable)
+			assert.EqualError(t, err, service.ErrorDictionaryUnavailable.Error())
+		})
+	})
 	t.Run("ErrorLogPlayedWord", func(t *testing.T) {
 		t.Run("Unexpected", func(t *testing.T) {
 			trans := &Transactional{}
@@ -219,7 +438,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			svc := service.NewService(trans, map[string]dictionary.Dictionary{
 				"id-id": dict,
 			})
-			_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 			assert.EqualError(t, err, unexpectedError.Error())
 		})
 		t.Run("WordHavePlayed", func(t *testing.T) {
@@ -250,7 +469,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			svc := service.NewService(trans, map[string]dictionary.Dictionary{
 				"id-id": dict,
 			})
-			_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+			_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 			assert.EqualError(t, err, service.ErrorWordHavePlayed.Error())
 		})
 	})
@@ -285,7 +504,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 			svc := service.NewService(trans, map[string]dictionary.Dictionary{
 				"id-id": dict,
 			})
-			game, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
+			game, _, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
 			if assert.NoError(t, err) {
 				assert.Equal(t, expectedBoardPositioning, game.BoardPositioning)
 			}
@@ -325,6 +544,211 @@ func TestApplicationTakeTurn(t *testing.T) {
 			positioningSuite(boardPositioning, expectedBoardPositioning)
 		})
 	})
+	t.Run("Move", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{
+				CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+				BoardPositioning: []uint8{0, 1, 4, 2, 5, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				BoardModifiers:   []uint8{0, 0, 0, 0, uint8(data.BOMB), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				BoardBase:        boardBaseFresh(), State: data.ONGOING,
+				LetterBank: letterBank,
+			}, nil)
+		trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+			Return([]data.GamePlayer{
+				{GameId: gameId, PlayerId: players[0].Id},
+				{GameId: gameId, PlayerId: players[1].Id},
+			}, nil)
+		trans.On("LogPlayedWord", ctx, tx, gameId, playerId).
+			Return(nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, nil).
+			Return(nil)
+
+		dict := &Dictionary{}
+
+		dict.On("LemmaIsValid", "worda").
+			Return(true, nil)
+
+		svc := service.NewService(trans, map[string]dictionary.Dictionary{
+			"id-id": dict,
+		})
+		_, move, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
+		if assert.NoError(t, err) {
+			assert.Equal(t, playerId, move.PlayerId)
+			assert.Equal(t, "worda", move.Word)
+			assert.Equal(t, []uint8{0, 1, 2, 3, 4}, move.Positions)
+			assert.Equal(t, []uint8{0}, move.Claimed)
+			assert.Equal(t, []uint8{1}, move.Strengthened)
+			assert.Equal(t, map[uint8][]uint8{1: {3}}, move.Captured)
+			assert.Equal(t, []uint8{4}, move.Weakened)
+			assert.Equal(t, []uint8{9}, move.Reset)
+			assert.Len(t, move.Drawn, 5)
+		}
+	})
+	t.Run("Scoring", func(t *testing.T) {
+		scoringSuite := func(t *testing.T, settings data.GameSettings, expectedScores data.Scores) {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: 0, NumberOfPlayer: 2,
+					BoardPositioning: []uint8{2, 5, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+					BoardBase:        boardBaseFresh(), State: data.ONGOING,
+					LetterBank: letterBank, Settings: settings, Scores: data.Scores{0, 0},
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: players[0].Id},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("LogPlayedWord", ctx, tx, gameId, playerId).
+				Return(nil)
+			trans.On("UpdateGame").
+				Return(nil)
+			trans.On("FinalizeTransaction", tx, nil).
+				Return(nil)
+
+			dict := &Dictionary{}
+
+			dict.On("LemmaIsValid", "worda").
+				Return(true, nil)
+
+			svc := service.NewService(trans, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			game, _, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
+			if assert.NoError(t, err) {
+				assert.Equal(t, expectedScores, game.Scores)
+			}
+		}
+		t.Run("Area", func(t *testing.T) {
+			scoringSuite(t, data.GameSettings{Mode: data.AREA}, data.Scores{0, 0})
+		})
+		t.Run("Score", func(t *testing.T) {
+			// w(8) + o(4) + r(3) + d(3) + a(1), plus two captured tiles
+			scoringSuite(t, data.GameSettings{Mode: data.SCORE}, data.Scores{19 + 2*2, 0})
+		})
+	})
+	t.Run("Modifiers", func(t *testing.T) {
+		modifierSuite := func(t *testing.T, currentPlayerOrder uint8, boardPositioning, boardModifiers []uint8) data.Game {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(data.Game{
+					CurrentPlayerOrder: currentPlayerOrder, NumberOfPlayer: 2,
+					BoardPositioning: boardPositioning, BoardModifiers: boardModifiers,
+					BoardBase: boardBaseFresh(), State: data.ONGOING, LetterBank: letterBank,
+					Settings: data.GameSettings{Mode: data.SCORE}, Scores: data.Scores{0, 0},
+				}, nil)
+			trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+				Return([]data.GamePlayer{
+					{GameId: gameId, PlayerId: players[0].Id},
+					{GameId: gameId, PlayerId: players[1].Id},
+				}, nil)
+			trans.On("LogPlayedWord", ctx, tx, gameId, players[currentPlayerOrder].Id).
+				Return(nil)
+			trans.On("UpdateGame").
+				Return(nil)
+			trans.On("FinalizeTransaction", tx, nil).
+				Return(nil)
+
+			dict := &Dictionary{}
+
+			dict.On("LemmaIsValid", "worda").
+				Return(true, nil)
+
+			svc := service.NewService(trans, map[string]dictionary.Dictionary{
+				"id-id": dict,
+			})
+			game, _, err := svc.TakeTurn(ctx, gameId, players[currentPlayerOrder].Id, []uint8{0, 1, 2, 3, 4})
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			return game
+		}
+		t.Run("DoubleLetter", func(t *testing.T) {
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[0] = uint8(data.DOUBLE_LETTER)
+			game := modifierSuite(t, 0, make([]uint8, 25), boardModifiers)
+			// w is counted twice
+			assert.Equal(t, data.Scores{19 + 8, 0}, game.Scores)
+			assert.Equal(t, make([]uint8, 25), game.BoardModifiers)
+		})
+		t.Run("DoubleWord", func(t *testing.T) {
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[1] = uint8(data.DOUBLE_WORD)
+			boardModifiers[2] = uint8(data.DOUBLE_WORD)
+			game := modifierSuite(t, 0, make([]uint8, 25), boardModifiers)
+			assert.Equal(t, data.Scores{19 * 4, 0}, game.Scores)
+			assert.Equal(t, make([]uint8, 25), game.BoardModifiers)
+		})
+		t.Run("Lock", func(t *testing.T) {
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[0] = uint8(data.LOCK)
+			game := modifierSuite(t, 0, make([]uint8, 25), boardModifiers)
+			assert.Equal(t, uint8(data.LOCKED), game.BoardModifiers[0])
+		})
+		t.Run("LockOnWeakenedTile", func(t *testing.T) {
+			boardPositioning := make([]uint8, 25)
+			boardPositioning[0] = 2 + 3 // the opponent's, strength 2
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[0] = uint8(data.LOCK)
+			game := modifierSuite(t, 0, boardPositioning, boardModifiers)
+			assert.Equal(t, uint8(2), game.BoardPositioning[0], "weakened, still the opponent's")
+			assert.Equal(t, uint8(data.LOCK), game.BoardModifiers[0], "left for later")
+		})
+		t.Run("LockOnCapturedTile", func(t *testing.T) {
+			boardPositioning := make([]uint8, 25)
+			boardPositioning[0] = 2
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[0] = uint8(data.LOCK)
+			game := modifierSuite(t, 0, boardPositioning, boardModifiers)
+			assert.Equal(t, uint8(1), game.BoardPositioning[0])
+			assert.Equal(t, uint8(data.LOCKED), game.BoardModifiers[0])
+		})
+		t.Run("Locked", func(t *testing.T) {
+			t.Run("ProtectedFromOpponent", func(t *testing.T) {
+				boardPositioning := make([]uint8, 25)
+				boardPositioning[0] = 1
+				boardModifiers := make([]uint8, 25)
+				boardModifiers[0] = uint8(data.LOCKED)
+				game := modifierSuite(t, 1, boardPositioning, boardModifiers)
+				assert.Equal(t, uint8(1), game.BoardPositioning[0])
+				assert.Equal(t, uint8(data.LOCKED), game.BoardModifiers[0])
+			})
+			t.Run("ReleasedOnOwnerTurn", func(t *testing.T) {
+				boardPositioning := make([]uint8, 25)
+				boardPositioning[10] = 1
+				boardModifiers := make([]uint8, 25)
+				boardModifiers[10] = uint8(data.LOCKED)
+				game := modifierSuite(t, 0, boardPositioning, boardModifiers)
+				assert.Equal(t, uint8(data.PLAIN), game.BoardModifiers[10])
+			})
+		})
+		t.Run("Bomb", func(t *testing.T) {
+			boardPositioning := make([]uint8, 25)
+			boardPositioning[5] = 2
+			boardPositioning[7] = 2
+			boardPositioning[9] = 2
+			boardModifiers := make([]uint8, 25)
+			boardModifiers[2] = uint8(data.BOMB)
+			boardModifiers[4] = uint8(data.BOMB)
+			boardModifiers[7] = uint8(data.LOCKED)
+			game := modifierSuite(t, 0, boardPositioning, boardModifiers)
+			assert.Equal(t, uint8(2), game.BoardPositioning[5], "not a neighbour")
+			assert.Equal(t, uint8(2), game.BoardPositioning[7], "locked neighbour")
+			assert.Equal(t, uint8(0), game.BoardPositioning[9], "neighbour")
+			assert.Equal(t, []uint8{1, 1, 1, 1, 1}, game.BoardPositioning[:5], "played word")
+			assert.Equal(t, uint8(data.PLAIN), game.BoardModifiers[2])
+			assert.Equal(t, uint8(data.PLAIN), game.BoardModifiers[4])
+		})
+	})
 	t.Run("Ordering", func(t *testing.T) {
 		orderingSuite := func(currentPlayerOrder, nextOrder uint8) {
 			trans := &Transactional{}
@@ -356,9 +780,13 @@ func TestApplicationTakeTurn(t *testing.T) {
 			svc := service.NewService(trans, map[string]dictionary.Dictionary{
 				"id-id": dict,
 			})
-			game, err := svc.TakeTurn(ctx, gameId, players[currentPlayerOrder].Id, []uint8{0, 1, 2, 3, 4})
+			game, _, err := svc.TakeTurn(ctx, gameId, players[currentPlayerOrder].Id, []uint8{0, 1, 2, 3, 4})
 			if assert.NoError(t, err) {
 				assert.Equal(t, nextOrder, game.CurrentPlayerOrder)
+				assert.Equal(t, currentPlayerOrder, game.PreviousState.CurrentPlayerOrder)
+				assert.Equal(t, players[currentPlayerOrder].Id, game.PreviousState.PlayerId)
+				assert.Equal(t, "worda", game.PreviousState.Word)
+				assert.Equal(t, boardBase, game.PreviousState.BoardBase)
 			}
 		}
 		t.Run("NotExceeding", func(t *testing.T) {
@@ -399,10 +827,11 @@ func TestApplicationTakeTurn(t *testing.T) {
 			svc := service.NewService(trans, map[string]dictionary.Dictionary{
 				"id-id": dict,
 			})
-			game, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
+			game, _, err := svc.TakeTurn(ctx, gameId, playerId, []uint8{0, 1, 2, 3, 4})
 			if assert.NoError(t, err) {
 				if expectedEnd {
 					assert.Equal(t, data.END, game.State)
+					assert.Equal(t, data.GameSnapshot{}, game.PreviousState, "an ended game is not undone")
 				} else {
 					assert.Equal(t, data.ONGOING, game.State)
 				}
@@ -452,7 +881,7 @@ func TestApplicationTakeTurn(t *testing.T) {
 		svc := service.NewService(trans, map[string]dictionary.Dictionary{
 			"id-id": dict,
 		})
-		_, err := svc.TakeTurn(ctx, gameId, playerId, word)
+		_, _, err := svc.TakeTurn(ctx, gameId, playerId, word)
 		assert.EqualError(t, err, unexpectedError.Error())
 	})
 }
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+func (a *application) RequestUndo(ctx context.Context, gameId data.GameId, playerId data.PlayerId) (game data.Game, err error) {
+	tx, err := a.transactional.BeginTransaction(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = a.transactional.FinalizeTransaction(tx, err)
+	}()
+
+	game, err = a.transactional.GetGameById(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+
+	// only the last move of an ongoing game could be undone, and only by whom made it
+	if game.State != data.ONGOING || !lastMove(game) || game.PreviousState.PlayerId != playerId {
+		err = ErrorUndoNotAllowed
+		return
+	}
+
+	game.PreviousState.UndoRequested = true
+	game.PreviousState.UndoApprovals = nil
+
+	err = a.transactional.UpdateGame(ctx, tx, game)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (a *application) RespondUndo(ctx context.Context, gameId data.GameId, playerId data.PlayerId, accept bool) (game data.Game, err error) {
+	tx, err := a.transactional.BeginTransaction(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = a.transactional.FinalizeTransaction(tx, err)
+	}()
+
+	game, err = a.transactional.GetGameById(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+
+	if !game.PreviousState.UndoRequested {
+		err = ErrorNoUndoRequested
+		return
+	}
+
+	gamePlayers, err := a.transactional.GetGamePlayersByGameId(ctx, tx, gameId)
+	if err != nil {
+		return
+	}
+
+	opponent := false
+	for _, gamePlayer := range gamePlayers {
+		if gamePlayer.PlayerId == playerId && playerId != game.PreviousState.PlayerId {
+			opponent = true
+		}
+	}
+	if !opponent {
+		err = ErrorUnauthorized
+		return
+	}
+
+	game.Players = []data.Player{}
+	for _, gamePlayer := range gamePlayers {
+		game.Players = append(game.Players, data.Player{Id: gamePlayer.PlayerId})
+	}
+
+	if !accept {
+		game.PreviousState.UndoRequested = false
+		game.PreviousState.UndoApprovals = nil
+	} else {
+		approved := false
+		for _, approval := range game.PreviousState.UndoApprovals {
+			approved = approved || approval == playerId
+		}
+		if !approved {
+			game.PreviousState.UndoApprovals = append(game.PreviousState.UndoApprovals, playerId)
+		}
+
+		// every opponent should agree
+		if len(game.PreviousState.UndoApprovals) >= len(gamePlayers)-1 {
+			err = a.transactional.DeletePlayedWord(ctx, tx, gameId, game.PreviousState.Word)
+			if err != nil {
+				return
+			}
+			forgetPendingMove(&game)
+			game.Restore(game.PreviousState)
+		}
+	}
+
+	err = a.transactional.UpdateGame(ctx, tx, game)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// lastMove tells whether the snapshot is of the move right before the current turn.
+func lastMove(game data.Game) bool {
+	return game.PreviousState.PlayerId != 0 && game.NumberOfPlayer > 0 &&
+		(game.PreviousState.CurrentPlayerOrder+1)%game.NumberOfPlayer == game.CurrentPlayerOrder
+}
+
+// forgetPendingMove drops the last move from the pending moves as it is taken back, it is not to be verified anymore.
+func forgetPendingMove(game *data.Game) {
+	if last := len(game.PendingMoves) - 1; last >= 0 && game.PendingMoves[last].Word == game.PreviousState.Word {
+		game.PendingMoves = game.PendingMoves[:last]
+	}
+}
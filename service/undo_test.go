@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplication_RequestUndo(t *testing.T) {
+	previousState := data.GameSnapshot{PlayerId: players[0].Id, Word: "kata", CurrentPlayerOrder: 0}
+	undoGame := func() data.Game {
+		return data.Game{CurrentPlayerOrder: 1, NumberOfPlayer: 2, State: data.ONGOING, PreviousState: previousState}
+	}
+	t.Run("ErrorGetGameById", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{}, unexpectedError)
+		trans.On("FinalizeTransaction", tx, unexpectedError).
+			Return(nil)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		_, err := svc.RequestUndo(ctx, gameId, players[0].Id)
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("ErrorUndoNotAllowed", func(t *testing.T) {
+		undoSuite := func(t *testing.T, game data.Game, playerId data.PlayerId) {
+			trans := &Transactional{}
+			trans.On("BeginTransaction", ctx).
+				Return(tx, nil)
+			trans.On("GetGameById", ctx, tx, gameId).
+				Return(game, nil)
+			trans.On("FinalizeTransaction", tx, service.ErrorUndoNotAllowed).
+				Return(nil)
+
+			svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+			_, err := svc.RequestUndo(ctx, gameId, playerId)
+			assert.EqualError(t, err, service.ErrorUndoNotAllowed.Error())
+			trans.AssertNotCalled(t, "UpdateGame")
+		}
+		t.Run("NotOwnMove", func(t *testing.T) {
+			undoSuite(t, undoGame(), players[1].Id)
+		})
+		t.Run("NotLastMove", func(t *testing.T) {
+			game := undoGame()
+			game.CurrentPlayerOrder = 0
+			undoSuite(t, game, players[0].Id)
+		})
+		t.Run("GameEnded", func(t *testing.T) {
+			game := undoGame()
+			game.State = data.END
+			undoSuite(t, game, players[0].Id)
+		})
+	})
+	t.Run("Success", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(undoGame(), nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, nil).
+			Return(nil)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		game, err := svc.RequestUndo(ctx, gameId, players[0].Id)
+		if assert.NoError(t, err) {
+			assert.True(t, game.PreviousState.UndoRequested)
+		}
+	})
+}
+
+func TestApplication_RespondUndo(t *testing.T) {
+	previousState := data.GameSnapshot{
+		PlayerId: players[0].Id, Word: "kata", UndoRequested: true,
+		CurrentPlayerOrder: 0, State: data.ONGOING,
+		BoardPositioning: make([]uint8, 25), BoardBase: boardBaseFresh(), LetterBank: letterBank,
+	}
+	respondSuite := func(t *testing.T, respondent data.PlayerId, accept bool, expectedError error) (*Transactional, data.Game, error) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{
+				CurrentPlayerOrder: 1, NumberOfPlayer: 2, State: data.ONGOING,
+				BoardPositioning: []uint8{1, 1, 1, 1}, PreviousState: previousState,
+				PendingMoves: data.PendingMoves{{PlayerId: players[1].Id, Word: "tidur", Pending: true}, previousState},
+			}, nil)
+		trans.On("GetGamePlayersByGameId", ctx, tx, gameId).
+			Return(gamePlayers, nil)
+		trans.On("DeletePlayedWord", ctx, tx, gameId, "kata").
+			Return(nil)
+		trans.On("UpdateGame").
+			Return(nil)
+		trans.On("FinalizeTransaction", tx, expectedError).
+			Return(nil)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		game, err := svc.RespondUndo(ctx, gameId, respondent, accept)
+		return trans, game, err
+	}
+	t.Run("ErrorNoUndoRequested", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("BeginTransaction", ctx).
+			Return(tx, nil)
+		trans.On("GetGameById", ctx, tx, gameId).
+			Return(data.Game{}, nil)
+		trans.On("FinalizeTransaction", tx, service.ErrorNoUndoRequested).
+			Return(nil)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		_, err := svc.RespondUndo(ctx, gameId, players[1].Id, true)
+		assert.EqualError(t, err, service.ErrorNoUndoRequested.Error())
+	})
+	t.Run("ErrorUnauthorized", func(t *testing.T) {
+		t.Run("Requester", func(t *testing.T) {
+			_, _, err := respondSuite(t, players[0].Id, true, service.ErrorUnauthorized)
+			assert.EqualError(t, err, service.ErrorUnauthorized.Error())
+		})
+		t.Run("Stranger", func(t *testing.T) {
+			_, _, err := respondSuite(t, players[1].Id+1, true, service.ErrorUnauthorized)
+			assert.EqualError(t, err, service.ErrorUnauthorized.Error())
+		})
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		trans, game, err := respondSuite(t, players[1].Id, false, nil)
+		if assert.NoError(t, err) {
+			assert.False(t, game.PreviousState.UndoRequested)
+			assert.Equal(t, uint8(1), game.CurrentPlayerOrder)
+			trans.AssertNotCalled(t, "DeletePlayedWord", ctx, tx, gameId, "kata")
+		}
+	})
+	t.Run("Accepted", func(t *testing.T) {
+		trans, game, err := respondSuite(t, players[1].Id, true, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.GameSnapshot{}, game.PreviousState)
+			assert.Equal(t, uint8(0), game.CurrentPlayerOrder)
+			assert.Equal(t, data.ONGOING, game.State)
+			assert.Equal(t, make([]uint8, 25), game.BoardPositioning)
+			assert.Equal(t, data.PendingMoves{{PlayerId: players[1].Id, Word: "tidur", Pending: true}}, game.PendingMoves, "the undone move is not verified")
+			trans.AssertCalled(t, "DeletePlayedWord", ctx, tx, gameId, "kata")
+		}
+	})
+}
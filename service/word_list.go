@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/satriahrh/letter-block/data"
+)
+
+// maximumHouseWords bounds a saved list and each of the allowed and banned words of a game
+const maximumHouseWords = 1000
+
+// SaveWordList saves the words for the player to allow or ban in their games later.
+func (a *application) SaveWordList(ctx context.Context, playerId data.PlayerId, name string, words []string) (data.WordList, error) {
+	name = strings.TrimSpace(name)
+	words = normalizeWords(words)
+	if name == "" || len(words) == 0 || len(words) > maximumHouseWords {
+		return data.WordList{}, ErrorWordListInvalid
+	}
+
+	return a.transactional.InsertWordList(ctx, data.WordList{
+		PlayerId: playerId,
+		Name:     name,
+		Words:    words,
+	})
+}
+
+func (a *application) GetWordLists(ctx context.Context, playerId data.PlayerId) ([]data.WordList, error) {
+	return a.transactional.GetWordListsByPlayerId(ctx, playerId)
+}
+
+// houseRules normalizes the words of the rules and copies in the words of the referenced lists,
+// which should be saved by the host.
+func (a *application) houseRules(ctx context.Context, hostId data.PlayerId, rules data.HouseRules) (data.HouseRules, error) {
+	allowedWords, err := a.wordListsWords(ctx, hostId, rules.AllowedLists)
+	if err != nil {
+		return rules, err
+	}
+	bannedWords, err := a.wordListsWords(ctx, hostId, rules.BannedLists)
+	if err != nil {
+		return rules, err
+	}
+
+	rules.AllowedWords = normalizeWords(append(rules.AllowedWords, allowedWords...))
+	rules.BannedWords = normalizeWords(append(rules.BannedWords, bannedWords...))
+	if len(rules.AllowedWords) > maximumHouseWords || len(rules.BannedWords) > maximumHouseWords {
+		return rules, ErrorGameSettings
+	}
+	return rules, nil
+}
+
+func (a *application) wordListsWords(ctx context.Context, hostId data.PlayerId, wordListIds []data.WordListId) (words []string, err error) {
+	for _, wordListId := range wordListIds {
+		wordList, err := a.transactional.GetWordListById(ctx, wordListId)
+		if err == sql.ErrNoRows {
+			return nil, ErrorWordListInvalid
+		}
+		if err != nil {
+			return nil, err
+		}
+		if wordList.PlayerId != hostId {
+			return nil, ErrorUnauthorized
+		}
+		words = append(words, wordList.Words...)
+	}
+	return
+}
+
+// normalizeWords normalizes the words as the played ones, dropping the empty and the repeated ones.
+func normalizeWords(words []string) []string {
+	var normalized []string
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		word = data.FoldWord(strings.TrimSpace(word))
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		normalized = append(normalized, word)
+	}
+	return normalized
+}
@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/satriahrh/letter-block/data"
+	"github.com/satriahrh/letter-block/dictionary"
+	"github.com/satriahrh/letter-block/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplication_SaveWordList(t *testing.T) {
+	t.Run("ErrorWordListInvalid", func(t *testing.T) {
+		svc := service.NewService(&Transactional{}, make(map[string]dictionary.Dictionary))
+
+		_, err := svc.SaveWordList(ctx, playerId, " ", []string{"anjay"})
+		assert.EqualError(t, err, service.ErrorWordListInvalid.Error(), "no name")
+
+		_, err = svc.SaveWordList(ctx, playerId, "slang", []string{" ", ""})
+		assert.EqualError(t, err, service.ErrorWordListInvalid.Error(), "no word")
+	})
+	t.Run("ErrorInsertWordList", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+		trans := &Transactional{}
+		trans.On("InsertWordList", ctx, data.WordList{PlayerId: playerId, Name: "slang", Words: data.Words{"anjay"}}).
+			Return(unexpectedError)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		_, err := svc.SaveWordList(ctx, playerId, "slang", []string{"anjay"})
+		assert.EqualError(t, err, unexpectedError.Error())
+	})
+	t.Run("Success", func(t *testing.T) {
+		trans := &Transactional{}
+		trans.On("InsertWordList", ctx, data.WordList{PlayerId: playerId, Name: "slang", Words: data.Words{"anjay", "gokil"}}).
+			Return(nil)
+
+		svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+		wordList, err := svc.SaveWordList(ctx, playerId, " slang ", []string{"Anjay", "gokil", "anjay"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, data.WordList{Id: wordListId, PlayerId: playerId, Name: "slang", Words: data.Words{"anjay", "gokil"}}, wordList)
+		}
+	})
+}
+
+func TestApplication_GetWordLists(t *testing.T) {
+	wordLists := []data.WordList{{Id: wordListId, PlayerId: playerId, Name: "slang", Words: data.Words{"anjay"}}}
+	trans := &Transactional{}
+	trans.On("GetWordListsByPlayerId", ctx, playerId).
+		Return(wordLists, nil)
+
+	svc := service.NewService(trans, make(map[string]dictionary.Dictionary))
+	actual, err := svc.GetWordLists(ctx, playerId)
+	if assert.NoError(t, err) {
+		assert.Equal(t, wordLists, actual)
+	}
+}